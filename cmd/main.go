@@ -3,13 +3,19 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"assigning-reviewers-for-pr/internal/transport/http/server/handlers-fiber"
 	"assigning-reviewers-for-pr/internal/usecase"
+	"assigning-reviewers-for-pr/internal/usecase/codehost"
+	"assigning-reviewers-for-pr/internal/usecase/selector"
+	"assigning-reviewers-for-pr/internal/usecase/webhook"
 
 	"assigning-reviewers-for-pr/config"
+	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/oapi"
 	"assigning-reviewers-for-pr/internal/repository"
 	"assigning-reviewers-for-pr/internal/transport/http/middleware"
@@ -47,8 +53,52 @@ func main() {
 		_ = repo.OnStop(context.Background())
 	}()
 
+	sel, err := selector.NewRegistry(entities.SelectionMode(cfg.Assignment.Strategy))
+	if err != nil {
+		log.Errorw("reviewer selector initialization error", "error", err)
+		return
+	}
+
+	// Background workers stop and are waited for before the deferred repo.OnStop closes the pool.
+	workerCtx, cancelWorkers := context.WithCancel(ctx)
+	var workers sync.WaitGroup
+	defer func() {
+		cancelWorkers()
+		workers.Wait()
+	}()
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
 	timeout := cfg.HTTP.RequestTimeout
-	uc := usecase.New(log, ctx, repo, timeout)
+	uc := usecase.New(log, ctx, repo, sel, timeout)
+	if interval := cfg.Assignment.UnavailabilityCheckInterval; interval > 0 {
+		runWorker(func(ctx context.Context) { uc.RunUnavailabilityWatcher(ctx, interval) })
+	}
+	if sla, interval := cfg.Assignment.ReviewSLA, cfg.Assignment.SLACheckInterval; sla > 0 && interval > 0 {
+		runWorker(func(ctx context.Context) { uc.RunSLAWatcher(ctx, interval, sla) })
+	}
+	if interval := cfg.Webhook.DispatchInterval; interval > 0 {
+		dispatcher := webhook.NewDispatcher(log, repo, &http.Client{}, webhook.Config{
+			MaxAttempts:    cfg.Webhook.MaxAttempts,
+			BackoffBase:    cfg.Webhook.BackoffBase,
+			BackoffMax:     cfg.Webhook.BackoffMax,
+			RequestTimeout: cfg.Webhook.RequestTimeout,
+		})
+		runWorker(func(ctx context.Context) { dispatcher.Run(ctx, interval) })
+	}
+	if interval := cfg.Events.PollInterval; interval > 0 {
+		runWorker(func(ctx context.Context) { uc.RunEventStream(ctx, interval, cfg.Events.GapTimeout) })
+	} else {
+		uc.DisableEventStream()
+	}
+	if retention, interval := cfg.Events.Retention, cfg.Events.PruneInterval; retention > 0 && interval > 0 {
+		runWorker(func(ctx context.Context) { uc.RunEventPruner(ctx, interval, retention) })
+	}
 
 	serv := fiber.New(fiber.Config{
 		ReadTimeout:  cfg.HTTP.RequestTimeout,
@@ -62,7 +112,38 @@ func main() {
 		return c.SendStatus(fiber.StatusOK)
 	})
 
-	h := handlers_fiber.NewHandler(log, uc)
+	logins, err := codehost.ParseLogins(cfg.CodeHost.UserMap)
+	if err != nil {
+		log.Errorw("code host user map error", "error", err)
+		return
+	}
+	var hosts handlers_fiber.CodeHosts
+	if cfg.GitHub.WebhookSecret != "" {
+		hosts.GitHub = codehost.NewGitHub(cfg.GitHub.WebhookSecret, logins.For(codehost.HostGitHub))
+	}
+	if cfg.GitLab.WebhookToken != "" {
+		hosts.GitLab = codehost.NewGitLab(cfg.GitLab.WebhookToken, logins.For(codehost.HostGitLab))
+	}
+	if interval := cfg.GitHub.SyncInterval; cfg.GitHub.SyncEnabled() {
+		repos, err := codehost.ParseRepositories(cfg.GitHub.SyncRepositories, cfg.GitHub.APIToken)
+		if err != nil {
+			log.Errorw("github sync repositories error", "error", err)
+			return
+		}
+		syncer := codehost.NewGitHubSyncer(log, repo, &http.Client{}, repos, logins.For(codehost.HostGitHub), codehost.SyncConfig{
+			APIURL:         cfg.GitHub.APIURL,
+			MaxAttempts:    cfg.GitHub.SyncMaxAttempts,
+			BackoffBase:    cfg.GitHub.SyncBackoffBase,
+			BackoffMax:     cfg.GitHub.SyncBackoffMax,
+			RequestTimeout: cfg.GitHub.SyncRequestTimeout,
+		})
+		runWorker(func(ctx context.Context) { syncer.Run(ctx, interval) })
+	}
+	if retention, interval := cfg.GitHub.SyncRetention, cfg.GitHub.SyncPruneInterval; retention > 0 && interval > 0 {
+		runWorker(func(ctx context.Context) { uc.RunCodeHostSyncPruner(ctx, interval, retention) })
+	}
+
+	h := handlers_fiber.NewHandler(log, uc, hosts)
 	api.RegisterHandlers(serv, h)
 
 	go func() {
@@ -51,6 +51,36 @@ func setDefaults(v *viper.Viper) {
 
 	v.SetDefault("http.request_timeout", 3*time.Second)
 
+	v.SetDefault("assignment.strategy", "random")
+	v.SetDefault("assignment.unavailability_check_interval", time.Minute)
+	v.SetDefault("assignment.review_sla", time.Duration(0))
+	v.SetDefault("assignment.sla_check_interval", time.Minute)
+
+	v.SetDefault("webhook.dispatch_interval", 5*time.Second)
+	v.SetDefault("webhook.request_timeout", 5*time.Second)
+	v.SetDefault("webhook.max_attempts", 10)
+	v.SetDefault("webhook.backoff_base", 10*time.Second)
+	v.SetDefault("webhook.backoff_max", time.Hour)
+
+	v.SetDefault("events.poll_interval", time.Second)
+	v.SetDefault("events.gap_timeout", 5*time.Second)
+	v.SetDefault("events.retention", 7*24*time.Hour)
+	v.SetDefault("events.prune_interval", time.Hour)
+
+	v.SetDefault("codehost.user_map", "")
+	v.SetDefault("github.webhook_secret", "")
+	v.SetDefault("github.api_url", "https://api.github.com")
+	v.SetDefault("github.api_token", "")
+	v.SetDefault("github.sync_repositories", "")
+	v.SetDefault("github.sync_interval", 5*time.Second)
+	v.SetDefault("github.sync_request_timeout", 5*time.Second)
+	v.SetDefault("github.sync_max_attempts", 10)
+	v.SetDefault("github.sync_backoff_base", 10*time.Second)
+	v.SetDefault("github.sync_backoff_max", time.Hour)
+	v.SetDefault("github.sync_retention", 7*24*time.Hour)
+	v.SetDefault("github.sync_prune_interval", time.Hour)
+	v.SetDefault("gitlab.webhook_token", "")
+
 	v.SetDefault("postgres.host", "localhost")
 	v.SetDefault("postgres.port", 5432)
 	v.SetDefault("postgres.user", "postgres")
@@ -71,6 +101,32 @@ func bindEnvs(v *viper.Viper) {
 		"server.port",
 		"server.shutdown_timeout",
 		"http.request_timeout",
+		"assignment.strategy",
+		"assignment.unavailability_check_interval",
+		"assignment.review_sla",
+		"assignment.sla_check_interval",
+		"webhook.dispatch_interval",
+		"webhook.request_timeout",
+		"webhook.max_attempts",
+		"webhook.backoff_base",
+		"webhook.backoff_max",
+		"events.poll_interval",
+		"events.gap_timeout",
+		"events.retention",
+		"events.prune_interval",
+		"codehost.user_map",
+		"github.webhook_secret",
+		"github.api_url",
+		"github.api_token",
+		"github.sync_repositories",
+		"github.sync_interval",
+		"github.sync_request_timeout",
+		"github.sync_max_attempts",
+		"github.sync_backoff_base",
+		"github.sync_backoff_max",
+		"github.sync_retention",
+		"github.sync_prune_interval",
+		"gitlab.webhook_token",
 		"postgres.host",
 		"postgres.port",
 		"postgres.user",
@@ -8,10 +8,16 @@ import (
 
 // Config holds application configuration.
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Postgres PostgresConfig `mapstructure:"postgres"`
-	HTTP     HTTPConfig     `mapstructure:"http"`
-	Logging  LoggingConfig  `mapstructure:"logging"`
+	Server     ServerConfig     `mapstructure:"server"`
+	Postgres   PostgresConfig   `mapstructure:"postgres"`
+	HTTP       HTTPConfig       `mapstructure:"http"`
+	Logging    LoggingConfig    `mapstructure:"logging"`
+	Assignment AssignmentConfig `mapstructure:"assignment"`
+	Webhook    WebhookConfig    `mapstructure:"webhook"`
+	Events     EventsConfig     `mapstructure:"events"`
+	CodeHost   CodeHostConfig   `mapstructure:"codehost"`
+	GitHub     GitHubConfig     `mapstructure:"github"`
+	GitLab     GitLabConfig     `mapstructure:"gitlab"`
 }
 
 // Validate ensures required fields are present.
@@ -50,6 +56,81 @@ type LoggingConfig struct {
 	Level string `mapstructure:"level"`
 }
 
+// AssignmentConfig contains reviewer assignment settings.
+type AssignmentConfig struct {
+	Strategy string `mapstructure:"strategy"`
+	// UnavailabilityCheckInterval is how often started out-of-office periods are processed; 0 disables it.
+	UnavailabilityCheckInterval time.Duration `mapstructure:"unavailability_check_interval"`
+	// ReviewSLA is how long a review may stay pending before it is reassigned; 0 disables it.
+	ReviewSLA time.Duration `mapstructure:"review_sla"`
+	// SLACheckInterval is how often reviews are checked against ReviewSLA.
+	SLACheckInterval time.Duration `mapstructure:"sla_check_interval"`
+}
+
+// WebhookConfig contains outbound webhook delivery settings.
+type WebhookConfig struct {
+	// DispatchInterval is how often due deliveries are sent; 0 disables delivery.
+	DispatchInterval time.Duration `mapstructure:"dispatch_interval"`
+	RequestTimeout   time.Duration `mapstructure:"request_timeout"`
+	// MaxAttempts is how many times a delivery is tried before it is marked failed.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// BackoffBase is the delay before the first retry; it doubles with every further one.
+	BackoffBase time.Duration `mapstructure:"backoff_base"`
+	BackoffMax  time.Duration `mapstructure:"backoff_max"`
+}
+
+// EventsConfig contains live event stream settings.
+type EventsConfig struct {
+	// PollInterval is how often the event log is checked for new events; 0 disables the stream.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// GapTimeout is how long a missing event id is awaited before it is skipped as rolled back.
+	GapTimeout time.Duration `mapstructure:"gap_timeout"`
+	// Retention is how long events stay in the log for replay; 0 keeps them forever.
+	Retention time.Duration `mapstructure:"retention"`
+	// PruneInterval is how often events older than Retention are deleted.
+	PruneInterval time.Duration `mapstructure:"prune_interval"`
+}
+
+// CodeHostConfig contains settings shared by the code host integrations.
+type CodeHostConfig struct {
+	// UserMap is a "login=user_id,github:login=user_id" list; unmapped logins are used as user IDs as is.
+	UserMap string `mapstructure:"user_map"`
+}
+
+// GitHubConfig contains GitHub webhook ingestion and reviewer push settings.
+type GitHubConfig struct {
+	// WebhookSecret verifies X-Hub-Signature-256 of deliveries; empty disables the endpoint.
+	WebhookSecret string `mapstructure:"webhook_secret"`
+	APIURL        string `mapstructure:"api_url"`
+	// APIToken is used for the sync repositories that have no token of their own.
+	APIToken string `mapstructure:"api_token"`
+	// SyncRepositories is an "owner/repo,owner/repo=token" list of repositories reviewers are pushed to.
+	SyncRepositories string `mapstructure:"sync_repositories"`
+	// SyncInterval is how often pending reviewer pushes are sent; 0 disables pushing.
+	SyncInterval       time.Duration `mapstructure:"sync_interval"`
+	SyncRequestTimeout time.Duration `mapstructure:"sync_request_timeout"`
+	// SyncMaxAttempts is how many times a push is tried before it is marked failed.
+	SyncMaxAttempts int `mapstructure:"sync_max_attempts"`
+	// SyncBackoffBase is the delay before the first retry of a push; it doubles with every further one.
+	SyncBackoffBase time.Duration `mapstructure:"sync_backoff_base"`
+	SyncBackoffMax  time.Duration `mapstructure:"sync_backoff_max"`
+	// SyncRetention is how long finished pushes stay listed; 0 keeps them forever.
+	SyncRetention time.Duration `mapstructure:"sync_retention"`
+	// SyncPruneInterval is how often finished pushes older than SyncRetention are deleted.
+	SyncPruneInterval time.Duration `mapstructure:"sync_prune_interval"`
+}
+
+// SyncEnabled reports whether reviewers are pushed to GitHub at all.
+func (g GitHubConfig) SyncEnabled() bool {
+	return g.SyncInterval > 0 && g.SyncRepositories != ""
+}
+
+// GitLabConfig contains GitLab webhook ingestion settings.
+type GitLabConfig struct {
+	// WebhookToken is compared with X-Gitlab-Token of deliveries; empty disables the endpoint.
+	WebhookToken string `mapstructure:"webhook_token"`
+}
+
 // PostgresConfig describes database connection parameters.
 type PostgresConfig struct {
 	Host           string        `mapstructure:"host"`
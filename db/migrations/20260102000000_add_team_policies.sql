@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team_policies (
+    team_id INTEGER PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    reviewer_count INTEGER NOT NULL DEFAULT 2 CHECK (reviewer_count BETWEEN 1 AND 10),
+    selection_mode TEXT,
+    exclude_author_team BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_policies;
+-- +goose StatementEnd
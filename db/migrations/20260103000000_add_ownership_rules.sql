@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ownership_rules (
+    id SERIAL PRIMARY KEY,
+    position INTEGER NOT NULL UNIQUE,
+    pattern TEXT NOT NULL
+);
+
+CREATE TABLE ownership_rule_owners (
+    rule_id INTEGER NOT NULL REFERENCES ownership_rules(id) ON DELETE CASCADE,
+    owner_kind TEXT NOT NULL CHECK (owner_kind IN ('user', 'team')),
+    owner_ref TEXT NOT NULL,
+    PRIMARY KEY (rule_id, owner_kind, owner_ref)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ownership_rule_owners;
+DROP TABLE IF EXISTS ownership_rules;
+-- +goose StatementEnd
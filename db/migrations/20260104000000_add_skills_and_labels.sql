@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_skills (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill TEXT NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE TABLE pr_labels (
+    pr_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    PRIMARY KEY (pr_id, label)
+);
+
+CREATE INDEX idx_pr_labels_label ON pr_labels(label);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pr_labels;
+DROP TABLE IF EXISTS user_skills;
+-- +goose StatementEnd
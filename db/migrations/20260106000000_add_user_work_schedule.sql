@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN work_start_minute SMALLINT NOT NULL DEFAULT 540 CHECK (work_start_minute BETWEEN 0 AND 1439),
+    ADD COLUMN work_end_minute SMALLINT NOT NULL DEFAULT 1080 CHECK (work_end_minute BETWEEN 0 AND 1439),
+    -- bit N set means time.Weekday(N) (0 = Sunday) is a working day; 62 = Monday..Friday
+    ADD COLUMN work_days SMALLINT NOT NULL DEFAULT 62 CHECK (work_days BETWEEN 1 AND 127);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_days,
+    DROP COLUMN IF EXISTS work_end_minute,
+    DROP COLUMN IF EXISTS work_start_minute,
+    DROP COLUMN IF EXISTS time_zone;
+-- +goose StatementEnd
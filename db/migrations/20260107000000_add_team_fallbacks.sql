@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team_fallbacks (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    fallback_team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, position),
+    UNIQUE (team_id, fallback_team_id),
+    CHECK (team_id <> fallback_team_id)
+);
+
+ALTER TABLE pr_reviewers ADD COLUMN fallback_team_id INTEGER REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE pr_reassignment_history ADD COLUMN fallback_team_id INTEGER REFERENCES teams(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pr_reassignment_history DROP COLUMN IF EXISTS fallback_team_id;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS fallback_team_id;
+DROP TABLE IF EXISTS team_fallbacks;
+-- +goose StatementEnd
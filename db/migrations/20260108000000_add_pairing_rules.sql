@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pairing_rules (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('block', 'require')),
+    author_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (author_id, reviewer_id),
+    CHECK (author_id <> reviewer_id)
+);
+
+CREATE INDEX idx_pairing_rules_reviewer_id ON pairing_rules(reviewer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pairing_rules;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    -- NULL means no cap on concurrent reviews of OPEN pull requests
+    ADD COLUMN max_open_reviews INTEGER CHECK (max_open_reviews > 0),
+    ADD COLUMN review_weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (review_weight > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS review_weight,
+    DROP COLUMN IF EXISTS max_open_reviews;
+-- +goose StatementEnd
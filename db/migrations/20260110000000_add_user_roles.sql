@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'junior' CHECK (role IN ('junior', 'senior', 'lead'));
+ALTER TABLE team_policies
+    ADD COLUMN require_senior BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_policies
+    DROP COLUMN IF EXISTS require_senior;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pr_reassignment_history ALTER COLUMN old_reviewer_id DROP NOT NULL;
+ALTER TABLE pr_reassignment_history ADD CONSTRAINT pr_reassignment_history_change_check
+    CHECK (old_reviewer_id IS NOT NULL OR new_reviewer_id IS NOT NULL);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pr_reassignment_history DROP CONSTRAINT IF EXISTS pr_reassignment_history_change_check;
+DELETE FROM pr_reassignment_history WHERE old_reviewer_id IS NULL;
+ALTER TABLE pr_reassignment_history ALTER COLUMN old_reviewer_id SET NOT NULL;
+-- +goose StatementEnd
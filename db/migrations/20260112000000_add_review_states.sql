@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pr_reviewers
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'pending'
+        CHECK (state IN ('pending', 'approved', 'changes_requested', 'declined')),
+    ADD COLUMN assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN submitted_at TIMESTAMPTZ;
+
+UPDATE pr_reviewers r
+SET assigned_at = COALESCE(
+    (SELECT MAX(h.changed_at) FROM pr_reassignment_history h
+     WHERE h.pr_id = r.pr_id AND h.new_reviewer_id = r.reviewer_id),
+    (SELECT pr.created_at FROM pull_requests pr WHERE pr.id = r.pr_id));
+
+CREATE INDEX idx_pr_reviewers_reviewer_state ON pr_reviewers(reviewer_id, state);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_state;
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS submitted_at,
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS state;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE team_policies
+    ADD COLUMN merge_min_reviewers INT NOT NULL DEFAULT 0 CHECK (merge_min_reviewers >= 0),
+    ADD COLUMN merge_require_active_reviewers BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN merge_min_age_seconds BIGINT NOT NULL DEFAULT 0 CHECK (merge_min_age_seconds >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_policies
+    DROP COLUMN IF EXISTS merge_min_age_seconds,
+    DROP COLUMN IF EXISTS merge_require_active_reviewers,
+    DROP COLUMN IF EXISTS merge_min_reviewers;
+-- +goose StatementEnd
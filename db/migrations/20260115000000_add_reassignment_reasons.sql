@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pr_reassignment_history ADD COLUMN reason TEXT;
+
+CREATE INDEX idx_pr_reviewers_pending_assigned_at ON pr_reviewers(assigned_at) WHERE state = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pr_reviewers_pending_assigned_at;
+ALTER TABLE pr_reassignment_history DROP COLUMN IF EXISTS reason;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE webhook_events RENAME TO domain_events;
+ALTER TABLE domain_events
+    ADD COLUMN user_ids TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN team_names TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE domain_events
+    DROP COLUMN IF EXISTS team_names,
+    DROP COLUMN IF EXISTS user_ids;
+ALTER TABLE domain_events RENAME TO webhook_events;
+-- +goose StatementEnd
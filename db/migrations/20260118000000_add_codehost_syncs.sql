@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE codehost_syncs (
+    pr_id TEXT PRIMARY KEY REFERENCES pull_requests(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'synced', 'failed', 'skipped')),
+    version BIGINT NOT NULL DEFAULT 1,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    synced_reviewers TEXT[] NOT NULL DEFAULT '{}',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at TIMESTAMPTZ,
+    response_code INTEGER,
+    last_error TEXT,
+    synced_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_codehost_syncs_due ON codehost_syncs(next_attempt_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS codehost_syncs;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ALTER COLUMN team_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Fails while removed members exist: they are still referenced by their PRs and reviews.
+ALTER TABLE users ALTER COLUMN team_id SET NOT NULL;
+-- +goose StatementEnd
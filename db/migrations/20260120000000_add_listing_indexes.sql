@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- PR listings are keyset-paginated newest first; the status and author filters keep that order.
+CREATE INDEX idx_pull_requests_created_at ON pull_requests(created_at, id);
+CREATE INDEX idx_pull_requests_status_created_at ON pull_requests(status, created_at, id);
+CREATE INDEX idx_pull_requests_author_created_at ON pull_requests(author_id, created_at, id);
+DROP INDEX IF EXISTS idx_pr_status;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX idx_pr_status ON pull_requests(status);
+DROP INDEX IF EXISTS idx_pull_requests_author_created_at;
+DROP INDEX IF EXISTS idx_pull_requests_status_created_at;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams
+    ADD COLUMN parent_id INTEGER REFERENCES teams(id) ON DELETE RESTRICT,
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_id <> id);
+
+CREATE INDEX idx_teams_parent_id ON teams(parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_teams_parent_id;
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS teams_parent_not_self,
+    DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
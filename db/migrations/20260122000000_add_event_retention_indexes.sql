@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pruning the event log scans by age and checks, then cascades to, the deliveries of each event.
+CREATE INDEX idx_domain_events_created_at ON domain_events(created_at);
+CREATE INDEX idx_webhook_deliveries_event ON webhook_deliveries(event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_webhook_deliveries_event;
+DROP INDEX IF EXISTS idx_domain_events_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pruning finished code host syncs scans them by age.
+CREATE INDEX idx_codehost_syncs_finished ON codehost_syncs(updated_at) WHERE status <> 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_codehost_syncs_finished;
+-- +goose StatementEnd
@@ -0,0 +1,118 @@
+// Package entities contains core business entities.
+package entities
+
+// SelectionMode names a reviewer selection strategy.
+type SelectionMode string
+
+const (
+	// SelectionRandom picks reviewers uniformly at random.
+	SelectionRandom SelectionMode = "random"
+	// SelectionRoundRobin rotates through candidates, preferring the least recently picked.
+	SelectionRoundRobin SelectionMode = "round_robin"
+	// SelectionLeastLoaded prefers candidates with the fewest assignments overall.
+	SelectionLeastLoaded SelectionMode = "least_loaded"
+	// SelectionLeastOpen prefers candidates with the fewest reviews on OPEN pull requests.
+	SelectionLeastOpen SelectionMode = "least_open"
+	// SelectionWorkingHours prefers candidates currently inside their working hours.
+	SelectionWorkingHours SelectionMode = "working_hours"
+)
+
+// IsValid reports whether m names a built-in selection strategy.
+func (m SelectionMode) IsValid() bool {
+	switch m {
+	case SelectionRandom, SelectionRoundRobin, SelectionLeastLoaded, SelectionLeastOpen, SelectionWorkingHours:
+		return true
+	default:
+		return false
+	}
+}
+
+// Candidate is a potential reviewer considered during assignment.
+type Candidate struct {
+	UserID      string
+	AssignCnt   int64
+	OpenReviews int64
+	Skills      []string
+	Schedule    WorkSchedule
+	Role        Role
+	// Weight is the relative share of picks; zero counts as DefaultReviewWeight.
+	Weight float64
+}
+
+// ReviewWeight returns the candidate weight, defaulting unset weights.
+func (c Candidate) ReviewWeight() float64 {
+	if c.Weight <= 0 {
+		return DefaultReviewWeight
+	}
+	return c.Weight
+}
+
+// SkillMatch returns how many of labels are covered by the candidate skills.
+func (c Candidate) SkillMatch(labels []string) int {
+	matched := 0
+	for _, l := range labels {
+		for _, s := range c.Skills {
+			if s == l {
+				matched++
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// SelectionRequest describes a single reviewer selection round.
+// An empty Mode lets the selector fall back to its configured default.
+// Preferred candidates (e.g. code owners) fill the slots first, Candidates take the rest.
+// When Labels are set, at least one free slot goes to the candidate whose skills cover them best.
+// When RequireSenior is set, the first slot goes to a senior-or-above candidate, preferred ones first.
+// DryRun asks stateful strategies not to remember the outcome.
+type SelectionRequest struct {
+	Mode          SelectionMode
+	Preferred     []Candidate
+	Candidates    []Candidate
+	Labels        []string
+	Count         int
+	DryRun        bool
+	RequireSenior bool
+}
+
+// ReviewerSelector picks up to Count reviewers out of a candidate pool.
+type ReviewerSelector interface {
+	Select(req SelectionRequest) []string
+}
+
+// ExclusionReason explains why a user is left out of the candidate pool.
+type ExclusionReason string
+
+const (
+	// ExcludedAtCapacity marks users whose open reviews already reach their cap.
+	ExcludedAtCapacity ExclusionReason = "at_capacity"
+	// ExcludedAuthor marks the PR author.
+	ExcludedAuthor ExclusionReason = "author"
+	// ExcludedBlocked marks reviewers blocked for the author by a pairing rule.
+	ExcludedBlocked ExclusionReason = "blocked"
+	// ExcludedInactive marks users with is_active=false.
+	ExcludedInactive ExclusionReason = "inactive"
+	// ExcludedUnavailable marks users inside an out-of-office period.
+	ExcludedUnavailable ExclusionReason = "unavailable"
+)
+
+// ExcludedCandidate is a user of the assignment scope who cannot be picked.
+type ExcludedCandidate struct {
+	UserID string
+	Reason ExclusionReason
+}
+
+// AssignmentPreview is the outcome of a reviewer selection that was not persisted.
+type AssignmentPreview struct {
+	Policy TeamPolicy
+	// Required are reviewers forced onto the PR by pairing rules.
+	Required   []Candidate
+	CodeOwners []Candidate
+	Candidates []Candidate
+	Excluded   []ExcludedCandidate
+	Reviewers  []string
+	// FallbackTeams lists fallback teams Reviewers were topped up from.
+	FallbackTeams []string
+}
@@ -0,0 +1,16 @@
+// Package entities contains core business entities.
+package entities
+
+import "time"
+
+// Unavailability is a dated out-of-office period of a user.
+// Users are excluded from reviewer selection while StartsAt <= now < EndsAt.
+type Unavailability struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+	// ReassignReviews requests moving the user's open reviews to teammates once the period starts.
+	ReassignReviews bool
+}
@@ -0,0 +1,51 @@
+// Package entities contains core business entities.
+package entities
+
+import (
+	"fmt"
+	"math"
+)
+
+// Review weight bounds; a full-time reviewer has the default weight.
+const (
+	DefaultReviewWeight = 1.0
+	MaxReviewWeight     = 100.0
+)
+
+// ReviewCapacity limits how many reviews a user carries and how often they are picked.
+type ReviewCapacity struct {
+	// MaxOpenReviews caps concurrent reviews on OPEN pull requests; nil means unlimited.
+	MaxOpenReviews *int
+	// Weight is the share of picks relative to other candidates, e.g. 0.5 for a half-time reviewer.
+	Weight float64
+}
+
+// DefaultReviewCapacity returns an unlimited capacity with the default weight.
+func DefaultReviewCapacity() ReviewCapacity {
+	return ReviewCapacity{Weight: DefaultReviewWeight}
+}
+
+// Validate checks that the cap is positive and the weight lies in (0, MaxReviewWeight].
+func (c ReviewCapacity) Validate() error {
+	if c.MaxOpenReviews != nil && *c.MaxOpenReviews <= 0 {
+		return fmt.Errorf("%w: max_open_reviews must be positive", ErrInvalidArgument)
+	}
+	if math.IsNaN(c.Weight) || c.Weight <= 0 || c.Weight > MaxReviewWeight {
+		return fmt.Errorf("%w: review_weight must be in (0, %g]", ErrInvalidArgument, MaxReviewWeight)
+	}
+	return nil
+}
+
+// Usage returns the share of the cap taken by open reviews, or nil when there is no cap.
+func (c ReviewCapacity) Usage(openReviews int64) *float64 {
+	if c.MaxOpenReviews == nil {
+		return nil
+	}
+	usage := float64(openReviews) / float64(*c.MaxOpenReviews)
+	return &usage
+}
+
+// Reached reports whether openReviews already fill the cap.
+func (c ReviewCapacity) Reached(openReviews int64) bool {
+	return c.MaxOpenReviews != nil && openReviews >= int64(*c.MaxOpenReviews)
+}
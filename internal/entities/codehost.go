@@ -0,0 +1,86 @@
+// Package entities contains core business entities.
+package entities
+
+import "time"
+
+// CodeHostAction is a PR lifecycle change reported by a code host webhook.
+type CodeHostAction string
+
+const (
+	// CodeHostOpened creates the PR, as a draft when the code host says so.
+	CodeHostOpened CodeHostAction = "opened"
+	// CodeHostReady marks a draft PR ready for review.
+	CodeHostReady CodeHostAction = "ready"
+	// CodeHostClosed closes the PR without merging it.
+	CodeHostClosed CodeHostAction = "closed"
+	// CodeHostMerged merges the PR.
+	CodeHostMerged CodeHostAction = "merged"
+	// CodeHostReopened reopens a closed PR.
+	CodeHostReopened CodeHostAction = "reopened"
+)
+
+// CodeHostEvent is a PR change received from a code host, with its author already mapped to a user ID.
+type CodeHostEvent struct {
+	Action CodeHostAction
+	// PR carries the ID for every action; Name, AuthorID and Status are set only for opened ones.
+	PR PullRequest
+}
+
+// CodeHostSyncStatus is the state of pushing a PR's reviewers to its code host.
+type CodeHostSyncStatus string
+
+const (
+	// SyncPending marks a PR whose reviewers changed since the last push, or whose push is being retried.
+	SyncPending CodeHostSyncStatus = "pending"
+	// SyncSynced marks a PR whose code host requests match its reviewers.
+	SyncSynced CodeHostSyncStatus = "synced"
+	// SyncFailed marks a PR whose push ran out of attempts.
+	SyncFailed CodeHostSyncStatus = "failed"
+	// SyncSkipped marks a PR that is not on a configured code host repository or is no longer open.
+	SyncSkipped CodeHostSyncStatus = "skipped"
+)
+
+// IsValid reports whether s is a known sync status.
+func (s CodeHostSyncStatus) IsValid() bool {
+	return s == SyncPending || s == SyncSynced || s == SyncFailed || s == SyncSkipped
+}
+
+// CodeHostSync is the reviewer push state of one PR.
+type CodeHostSync struct {
+	PRID   string
+	Status CodeHostSyncStatus
+	// Version grows with every reviewer change, so an attempt started before the change
+	// does not mark the newer reviewers synced.
+	Version         int64
+	Attempts        int
+	SyncedReviewers []string
+	ResponseCode    *int
+	LastError       *string
+	NextAttemptAt   time.Time
+	LastAttemptAt   *time.Time
+	SyncedAt        *time.Time
+	UpdatedAt       time.Time
+	// PRStatus and Reviewers are loaded only for syncs claimed for pushing.
+	PRStatus  PullRequestStatus
+	Reviewers []string
+}
+
+// CodeHostSyncAttempt is the outcome of pushing the reviewers of a PR once.
+type CodeHostSyncAttempt struct {
+	PRID    string
+	Version int64
+	Status  CodeHostSyncStatus
+	// SyncedReviewers replaces the pushed reviewer set when not nil.
+	SyncedReviewers []string
+	ResponseCode    *int
+	Error           string
+	// RetryIn delays the next attempt of a sync left pending.
+	RetryIn time.Duration
+}
+
+// CodeHostSyncFilter narrows the sync status list.
+type CodeHostSyncFilter struct {
+	PRID   string
+	Status *CodeHostSyncStatus
+	Limit  int
+}
@@ -12,14 +12,44 @@ var (
 	ErrTeamExists = errors.New("team exists")
 	// ErrTeamNotFound signals missing team.
 	ErrTeamNotFound = errors.New("team not found")
+	// ErrMemberOfOtherTeam signals adding a user who already belongs to another team without forcing a move.
+	ErrMemberOfOtherTeam = errors.New("user is a member of another team")
+	// ErrNotTeamMember signals removing a user who does not belong to the team.
+	ErrNotTeamMember = errors.New("user is not a member of the team")
+	// ErrAuthorHasOpenPRs signals removing a member who still authors open or draft PRs.
+	ErrAuthorHasOpenPRs = errors.New("user authors open pull requests")
 	// ErrPRExists signals duplicate PR id.
 	ErrPRExists = errors.New("pr exists")
 	// ErrPRNotFound signals missing PR.
 	ErrPRNotFound = errors.New("pr not found")
 	// ErrPRMerged signals modification attempt after merge.
 	ErrPRMerged = errors.New("pr merged")
+	// ErrInvalidStatus signals an operation not allowed in the current PR status.
+	ErrInvalidStatus = errors.New("invalid pr status")
 	// ErrNotAssigned signals user not assigned to PR.
 	ErrNotAssigned = errors.New("reviewer not assigned")
+	// ErrAlreadyAssigned signals user already reviewing the PR.
+	ErrAlreadyAssigned = errors.New("reviewer already assigned")
+	// ErrReviewNotStale signals a review acted on or reassigned before its SLA expired.
+	ErrReviewNotStale = errors.New("review not stale")
 	// ErrNoCandidate signals absence of replacement candidate.
 	ErrNoCandidate = errors.New("no candidate")
+	// ErrUnavailabilityNotFound signals missing out-of-office period.
+	ErrUnavailabilityNotFound = errors.New("unavailability not found")
+	// ErrPairingRuleNotFound signals missing pairing rule.
+	ErrPairingRuleNotFound = errors.New("pairing rule not found")
+	// ErrPairingRuleExists signals a second rule for the same author/reviewer pair.
+	ErrPairingRuleExists = errors.New("pairing rule exists")
+	// ErrPairingViolation signals an assignment that cannot satisfy pairing rules.
+	ErrPairingViolation = errors.New("pairing rule cannot be satisfied")
+	// ErrSeniorRequired signals an assignment that would leave a PR without a senior reviewer.
+	ErrSeniorRequired = errors.New("senior reviewer required")
+	// ErrWebhookNotFound signals missing webhook subscription.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrMergeBlocked signals a merge rejected by the merge policy of the author team.
+	ErrMergeBlocked = errors.New("merge preconditions not met")
+	// ErrInvalidSignature signals an inbound webhook whose signature or token does not match.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrStreamDisabled signals a subscription to the event stream while it is turned off.
+	ErrStreamDisabled = errors.New("event stream disabled")
 )
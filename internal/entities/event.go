@@ -0,0 +1,39 @@
+// Package entities contains core business entities.
+package entities
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Event is a persisted domain event; its ID is the position in the event stream.
+type Event struct {
+	ID   int64
+	Type WebhookEvent
+	// Payload is the JSON-encoded WebhookPayload, the same body webhooks receive.
+	Payload []byte
+	// UserIDs and TeamNames are the users the event concerns and their teams at the time.
+	UserIDs   []string
+	TeamNames []string
+	CreatedAt time.Time
+}
+
+// EventSubscription yields stream events in order until it is closed.
+type EventSubscription interface {
+	// Next blocks until the next event, ctx is done or the subscription ends.
+	Next(ctx context.Context) (Event, error)
+	Close()
+}
+
+// EventFilter narrows the event stream; empty fields match every event.
+type EventFilter struct {
+	UserID   string
+	TeamName string
+}
+
+// Matches reports whether e passes the filter.
+func (f EventFilter) Matches(e Event) bool {
+	return (f.UserID == "" || slices.Contains(e.UserIDs, f.UserID)) &&
+		(f.TeamName == "" || slices.Contains(e.TeamNames, f.TeamName))
+}
@@ -0,0 +1,60 @@
+// Package entities contains core business entities.
+package entities
+
+import "time"
+
+// Page is one page of a cursor-paginated listing.
+type Page[T any] struct {
+	Items []T
+	// NextCursor resumes the listing after the last item; empty on the last page.
+	NextCursor string
+}
+
+// PullRequestFilter narrows the PR listing, newest first; empty fields match every PR.
+type PullRequestFilter struct {
+	Status     *PullRequestStatus
+	AuthorID   string
+	ReviewerID string
+	// TeamName matches PRs authored by current members of the team.
+	TeamName string
+	// CreatedFrom is inclusive and CreatedTo exclusive.
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// After is the position of the last PR of the previous page.
+	After *PullRequestCursor
+	Limit int
+}
+
+// PullRequestCursor is a position in the newest-first PR listing.
+type PullRequestCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// UserFilter narrows the user listing ordered by ID; empty fields match every user.
+type UserFilter struct {
+	TeamName string
+	IsActive *bool
+	// Query matches a case-insensitive substring of the user ID or username.
+	Query string
+	// AfterID is the ID of the last user of the previous page.
+	AfterID string
+	Limit   int
+}
+
+// TeamFilter narrows the team listing ordered by name.
+type TeamFilter struct {
+	// Query matches a case-insensitive substring of the team name.
+	Query string
+	// AfterName is the name of the last team of the previous page.
+	AfterName string
+	Limit     int
+}
+
+// TeamSummary is a team listing entry.
+type TeamSummary struct {
+	Name          string
+	Parent        string
+	Members       int
+	ActiveMembers int
+}
@@ -0,0 +1,25 @@
+// Package entities contains core business entities.
+package entities
+
+// OwnerKind distinguishes user and team code owners.
+type OwnerKind string
+
+const (
+	// OwnerUser references a single user by id.
+	OwnerUser OwnerKind = "user"
+	// OwnerTeam references every member of a team by team name.
+	OwnerTeam OwnerKind = "team"
+)
+
+// Owner references a user or a team owning a set of paths.
+type Owner struct {
+	Kind OwnerKind
+	ID   string
+}
+
+// OwnershipRule maps a CODEOWNERS-style glob pattern to its owners.
+// Rules are ordered; for every path the last matching rule wins.
+type OwnershipRule struct {
+	Pattern string
+	Owners  []Owner
+}
@@ -0,0 +1,25 @@
+// Package entities contains core business entities.
+package entities
+
+// PairingKind distinguishes blocked and required author/reviewer pairs.
+type PairingKind string
+
+const (
+	// PairingBlock forbids assigning the reviewer to the author's PRs.
+	PairingBlock PairingKind = "block"
+	// PairingRequire makes the reviewer part of every PR of the author.
+	PairingRequire PairingKind = "require"
+)
+
+// IsValid reports whether k is a known pairing kind.
+func (k PairingKind) IsValid() bool {
+	return k == PairingBlock || k == PairingRequire
+}
+
+// PairingRule constrains who may review PRs of a given author.
+type PairingRule struct {
+	ID         int64
+	Kind       PairingKind
+	AuthorID   string
+	ReviewerID string
+}
@@ -7,12 +7,29 @@ import "time"
 type PullRequestStatus string
 
 const (
+	// StatusDraft marks PR as a draft; drafts get no reviewers until marked ready.
+	StatusDraft PullRequestStatus = "DRAFT"
 	// StatusOpen marks PR as open.
 	StatusOpen PullRequestStatus = "OPEN"
 	// StatusMerged marks PR as merged.
 	StatusMerged PullRequestStatus = "MERGED"
+	// StatusClosed marks PR as abandoned; it may be reopened.
+	StatusClosed PullRequestStatus = "CLOSED"
 )
 
+// PullRequestStatuses lists every PR status in lifecycle order.
+var PullRequestStatuses = []PullRequestStatus{StatusDraft, StatusOpen, StatusMerged, StatusClosed}
+
+// IsValid reports whether s is a known PR status.
+func (s PullRequestStatus) IsValid() bool {
+	for _, v := range PullRequestStatuses {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
 // PullRequest is a domain model of a PR.
 type PullRequest struct {
 	ID        string
@@ -22,6 +39,17 @@ type PullRequest struct {
 	Reviewers []string
 	CreatedAt *time.Time
 	MergedAt  *time.Time
+	ClosedAt  *time.Time
+	// ChangedFiles are repository paths touched by the PR, used for code owner routing.
+	ChangedFiles []string
+	// Labels are normalized tags describing the PR; reviewers with matching skills are preferred.
+	Labels []string
+	// CodeOwners are owners of ChangedFiles; they get reviewer slots before teammates.
+	CodeOwners []Owner
+	// FallbackTeams lists fallback teams current reviewers were drawn from.
+	FallbackTeams []string
+	// Reviews hold the per-reviewer review state.
+	Reviews []Review
 }
 
 // PullRequestShort is a compact projection for reviewer listings.
@@ -30,4 +58,6 @@ type PullRequestShort struct {
 	Name     string
 	AuthorID string
 	Status   PullRequestStatus
+	// ReviewState is the state of the listed reviewer's review.
+	ReviewState ReviewState
 }
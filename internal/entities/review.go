@@ -0,0 +1,60 @@
+// Package entities contains core business entities.
+package entities
+
+import "time"
+
+// ReviewState is the decision of a reviewer on a PR.
+type ReviewState string
+
+const (
+	// ReviewPending marks a review not submitted yet.
+	ReviewPending ReviewState = "pending"
+	// ReviewApproved marks an approving review.
+	ReviewApproved ReviewState = "approved"
+	// ReviewChangesRequested marks a review asking for changes.
+	ReviewChangesRequested ReviewState = "changes_requested"
+	// ReviewDeclined marks a reviewer refusing the review; they get replaced automatically.
+	ReviewDeclined ReviewState = "declined"
+)
+
+// IsValid reports whether s is a known review state.
+func (s ReviewState) IsValid() bool {
+	switch s {
+	case ReviewPending, ReviewApproved, ReviewChangesRequested, ReviewDeclined:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDecision reports whether s may be submitted by a reviewer.
+func (s ReviewState) IsDecision() bool {
+	return s.IsValid() && s != ReviewPending
+}
+
+// Review is the state of one reviewer assignment of a PR.
+type Review struct {
+	ReviewerID  string
+	State       ReviewState
+	AssignedAt  time.Time
+	SubmittedAt *time.Time
+}
+
+// ReassignReason explains why a reviewer was changed automatically.
+type ReassignReason string
+
+const (
+	// ReassignSLAExpired marks a pending review handed over after the review SLA expired.
+	ReassignSLAExpired ReassignReason = "sla_expired"
+	// ReassignReopenedIneligible marks a reviewer handed over on reopen because they became
+	// inactive, unavailable or full while the PR was closed.
+	ReassignReopenedIneligible ReassignReason = "reopened_ineligible"
+)
+
+// StaleReview is a pending review on an open PR that has waited longer than the review SLA.
+type StaleReview struct {
+	PRID       string
+	ReviewerID string
+	// WaitingSince is when the reviewer was assigned or the PR was last opened, whichever is later.
+	WaitingSince time.Time
+}
@@ -0,0 +1,29 @@
+// Package entities contains core business entities.
+package entities
+
+// Role is the seniority of a user.
+type Role string
+
+const (
+	// RoleJunior is the default role of new users.
+	RoleJunior Role = "junior"
+	// RoleSenior marks an experienced engineer.
+	RoleSenior Role = "senior"
+	// RoleLead marks a tech lead; leads count as senior reviewers.
+	RoleLead Role = "lead"
+)
+
+// IsValid reports whether r is a known role.
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleJunior, RoleSenior, RoleLead:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSenior reports whether r is senior or above.
+func (r Role) IsSenior() bool {
+	return r == RoleSenior || r == RoleLead
+}
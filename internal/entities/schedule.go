@@ -0,0 +1,111 @@
+// Package entities contains core business entities.
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones must resolve even on hosts without zoneinfo
+)
+
+// Default working hours applied to users without an explicit schedule.
+const (
+	DefaultTimeZone    = "UTC"
+	DefaultStartMinute = 9 * 60
+	DefaultEndMinute   = 18 * 60
+)
+
+// WorkSchedule describes weekly working hours of a user in their own time zone.
+// EndMinute before StartMinute denotes an overnight shift ending on the next day.
+type WorkSchedule struct {
+	TimeZone    string
+	StartMinute int
+	EndMinute   int
+	Days        []time.Weekday
+}
+
+// DefaultWorkSchedule returns 09:00-18:00 UTC, Monday to Friday.
+func DefaultWorkSchedule() WorkSchedule {
+	return WorkSchedule{
+		TimeZone:    DefaultTimeZone,
+		StartMinute: DefaultStartMinute,
+		EndMinute:   DefaultEndMinute,
+		Days:        []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	}
+}
+
+// Validate checks that the time zone resolves and the working interval is well formed.
+func (s WorkSchedule) Validate() error {
+	if _, err := time.LoadLocation(s.TimeZone); s.TimeZone == "" || err != nil {
+		return fmt.Errorf("%w: unknown time zone %q", ErrInvalidArgument, s.TimeZone)
+	}
+	if s.StartMinute < 0 || s.StartMinute >= 24*60 || s.EndMinute < 0 || s.EndMinute >= 24*60 || s.StartMinute == s.EndMinute {
+		return fmt.Errorf("%w: working hours must be two different times of day", ErrInvalidArgument)
+	}
+	if len(s.Days) == 0 {
+		return fmt.Errorf("%w: at least one working day is required", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// LocalTime converts t to the schedule time zone, falling back to UTC for unknown zones.
+func (s WorkSchedule) LocalTime(t time.Time) time.Time {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return t.In(loc)
+}
+
+// IsWorking reports whether t falls inside the working hours.
+func (s WorkSchedule) IsWorking(t time.Time) bool {
+	local := s.LocalTime(t)
+	minute := local.Hour()*60 + local.Minute()
+	if s.StartMinute < s.EndMinute {
+		return s.worksOn(local.Weekday()) && minute >= s.StartMinute && minute < s.EndMinute
+	}
+	if minute >= s.StartMinute {
+		return s.worksOn(local.Weekday())
+	}
+	return minute < s.EndMinute && s.worksOn((local.Weekday()+6)%7)
+}
+
+func (s WorkSchedule) worksOn(day time.Weekday) bool {
+	for _, d := range s.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+var weekdayNames = [...]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// WeekdayName returns the short lowercase name of a weekday, e.g. "mon".
+func WeekdayName(d time.Weekday) string {
+	return weekdayNames[d]
+}
+
+// ParseWeekday parses a short weekday name produced by WeekdayName.
+func ParseWeekday(name string) (time.Weekday, error) {
+	for i, n := range weekdayNames {
+		if strings.EqualFold(n, name) {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown weekday %q", ErrInvalidArgument, name)
+}
+
+// FormatClock renders minutes since midnight as HH:MM.
+func FormatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// ParseClock parses HH:MM into minutes since midnight.
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: time of day must be HH:MM, got %q", ErrInvalidArgument, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
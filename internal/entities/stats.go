@@ -24,6 +24,8 @@ type StatsFilter struct {
 	To     *time.Time
 	Status *PullRequestStatus
 	Limit  int
+	// Rollup counts the assignments of every team together with those of its descendants.
+	Rollup bool
 }
 
 // ReviewerStats contains aggregated data for a single reviewer.
@@ -32,14 +34,28 @@ type ReviewerStats struct {
 	AssignCnt   int64              `json:"assign_cnt"`
 	OpenPRCnt   int64              `json:"open_pr_cnt"`
 	MergedPRCnt int64              `json:"merged_pr_cnt"`
+	ClosedPRCnt int64              `json:"closed_pr_cnt"`
 	RecentPRs   []PullRequestShort `json:"recent_prs"`
+	// Schedule is the reviewer's working hours used to derive LocalTime.
+	Schedule       WorkSchedule `json:"-"`
+	LocalTime      *time.Time   `json:"local_time,omitempty"`
+	InWorkingHours bool         `json:"in_working_hours"`
+	// Capacity is the reviewer's cap and weight used to derive CapacityUsage.
+	Capacity      ReviewCapacity `json:"-"`
+	CapacityUsage *float64       `json:"capacity_usage,omitempty"`
+	AtCapacity    bool           `json:"at_capacity"`
 }
 
-// ReassignmentEvent captures a reviewer replacement.
+// ReassignmentEvent captures a reviewer change: a replacement, or an addition (no old reviewer)
+// or removal (no new reviewer).
 type ReassignmentEvent struct {
-	OldReviewerID string    `json:"old_reviewer_id"`
-	NewReviewerID *string   `json:"new_reviewer_id,omitempty"`
-	ChangedAt     time.Time `json:"changed_at"`
+	OldReviewerID *string `json:"old_reviewer_id,omitempty"`
+	NewReviewerID *string `json:"new_reviewer_id,omitempty"`
+	// FallbackTeam names the fallback team the new reviewer was drawn from.
+	FallbackTeam *string `json:"fallback_team,omitempty"`
+	// Reason is set for automatic changes, e.g. an expired review SLA.
+	Reason    *ReassignReason `json:"reason,omitempty"`
+	ChangedAt time.Time       `json:"changed_at"`
 }
 
 // PRStats contains statistics about a specific PR.
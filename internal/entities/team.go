@@ -1,8 +1,67 @@
 // Package entities contains core business entities.
 package entities
 
-// Team aggregates members under a team name.
+import "time"
+
+// Team aggregates members under a team name. Teams form a tree: a department
+// is a team whose children are teams, which in turn may have squads.
 type Team struct {
 	Name    string
 	Members []User
+	// Parent is the name of the parent team, empty for a top-level one.
+	Parent string
+	// Descendants lists the teams below this one on all levels, each with its members;
+	// it is loaded only on request.
+	Descendants []Team
+}
+
+// MembershipResult reports a user joining, moving between or leaving teams.
+type MembershipResult struct {
+	// Team is the team the user was added to or removed from, as it is after the change.
+	Team   Team
+	UserID string
+	// FromTeam is the team the user left, empty when they joined without one.
+	FromTeam string
+	// Reassigned and Removed count the open reviews of the user handed over to
+	// another reviewer or dropped without a replacement.
+	Reassigned int
+	Removed    int
+}
+
+const (
+	// DefaultReviewerCount is the number of reviewers assigned when a team has no policy.
+	DefaultReviewerCount = 2
+	// MaxReviewerCount caps the reviewer count a team policy may request.
+	MaxReviewerCount = 10
+)
+
+// TeamPolicy configures reviewer assignment for PRs authored by team members.
+type TeamPolicy struct {
+	TeamName      string
+	ReviewerCount int
+	// SelectionMode overrides the service-wide strategy; empty means the default one.
+	SelectionMode SelectionMode
+	// ExcludeAuthorTeam picks reviewers from other teams instead of the author's own.
+	ExcludeAuthorTeam bool
+	// FallbackTeams are tried in order when the regular pool cannot fill every reviewer slot.
+	FallbackTeams []string
+	// RequireSenior demands at least one senior-or-above reviewer on every PR.
+	RequireSenior bool
+	// Merge holds the preconditions checked before a PR of the team is merged.
+	Merge MergePolicy
+}
+
+// MergePolicy lists preconditions a PR must meet to be merged; zero values disable the checks.
+type MergePolicy struct {
+	// MinReviewers is the least number of reviewers assigned to the PR.
+	MinReviewers int
+	// RequireActiveReviewers rejects the merge while any assigned reviewer is inactive.
+	RequireActiveReviewers bool
+	// MinAge is how long the PR must have been open.
+	MinAge time.Duration
+}
+
+// DefaultTeamPolicy returns the policy applied to teams without an explicit one.
+func DefaultTeamPolicy(teamName string) TeamPolicy {
+	return TeamPolicy{TeamName: teamName, ReviewerCount: DefaultReviewerCount}
 }
@@ -7,4 +7,15 @@ type User struct {
 	Username string
 	TeamName string
 	IsActive bool
+	// Role is the user's seniority; empty on input keeps the stored role.
+	Role Role
+	// Skills are normalized expertise tags matched against pull request labels.
+	Skills []string
+	// Schedule holds working hours; nil when not loaded.
+	Schedule *WorkSchedule
+	// Capacity holds the review cap and weight; nil when not loaded.
+	Capacity *ReviewCapacity
 }
+
+// MaxTagLength limits the length of a single skill or label.
+const MaxTagLength = 64
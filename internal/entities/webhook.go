@@ -0,0 +1,135 @@
+// Package entities contains core business entities.
+package entities
+
+import "time"
+
+// WebhookEvent is a domain event type webhook subscribers may filter on.
+type WebhookEvent string
+
+const (
+	// EventPRCreated fires when a PR is created, as a draft or open.
+	EventPRCreated WebhookEvent = "pr.created"
+	// EventReviewerAssigned fires for every reviewer added to a PR without replacing another one.
+	EventReviewerAssigned WebhookEvent = "reviewer.assigned"
+	// EventReviewerReassigned fires when a reviewer is swapped for another one.
+	EventReviewerReassigned WebhookEvent = "reviewer.reassigned"
+	// EventPRMerged fires when an open PR gets merged.
+	EventPRMerged WebhookEvent = "pr.merged"
+	// EventTeamDeactivated fires when a team is deactivated.
+	EventTeamDeactivated WebhookEvent = "team.deactivated"
+	// EventPRClosed fires when a draft or open PR gets closed.
+	EventPRClosed WebhookEvent = "pr.closed"
+)
+
+// IsValid reports whether e is a known webhook event.
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case EventPRCreated, EventReviewerAssigned, EventReviewerReassigned, EventPRMerged, EventTeamDeactivated, EventPRClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookSubscription is an endpoint receiving the events it subscribed to.
+type WebhookSubscription struct {
+	ID     int64
+	URL    string
+	Secret string
+	Events []WebhookEvent
+	// CreatedAt is set by the storage.
+	CreatedAt time.Time
+}
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending marks a delivery waiting for its first attempt or a retry.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered marks a delivery acknowledged with a 2xx response.
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryFailed marks a delivery that ran out of attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// IsValid reports whether s is a known delivery status.
+func (s DeliveryStatus) IsValid() bool {
+	return s == DeliveryPending || s == DeliveryDelivered || s == DeliveryFailed
+}
+
+// WebhookDelivery is one event to be sent to one subscription.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	Event          WebhookEvent
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseCode   *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	// URL, Secret and Payload are loaded only for deliveries claimed for sending.
+	URL     string
+	Secret  string
+	Payload []byte
+}
+
+// WebhookAttempt is the outcome of sending a delivery once.
+type WebhookAttempt struct {
+	DeliveryID   int64
+	Status       DeliveryStatus
+	ResponseCode *int
+	Error        string
+	// RetryIn delays the next attempt of a delivery left pending.
+	RetryIn time.Duration
+}
+
+// DeliveryFilter narrows the webhook delivery log.
+type DeliveryFilter struct {
+	SubscriptionID *int64
+	Status         *DeliveryStatus
+	Limit          int
+}
+
+// WebhookPayload is the JSON body sent to subscribers.
+type WebhookPayload struct {
+	Event      WebhookEvent `json:"event"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	Data       any          `json:"data"`
+}
+
+// WebhookPR is the PR snapshot carried by PR events.
+type WebhookPR struct {
+	ID        string            `json:"pull_request_id"`
+	Name      string            `json:"pull_request_name"`
+	AuthorID  string            `json:"author_id"`
+	Status    PullRequestStatus `json:"status"`
+	Reviewers []string          `json:"assigned_reviewers"`
+}
+
+// NewWebhookPR snapshots pr for an event payload.
+func NewWebhookPR(pr PullRequest) WebhookPR {
+	reviewers := pr.Reviewers
+	if reviewers == nil {
+		reviewers = make([]string, 0)
+	}
+	return WebhookPR{ID: pr.ID, Name: pr.Name, AuthorID: pr.AuthorID, Status: pr.Status, Reviewers: reviewers}
+}
+
+// WebhookReviewerChange is the data of reviewer.assigned and reviewer.reassigned events.
+type WebhookReviewerChange struct {
+	PRID          string         `json:"pull_request_id"`
+	OldReviewerID string         `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string         `json:"new_reviewer_id"`
+	Reason        ReassignReason `json:"reason,omitempty"`
+}
+
+// WebhookTeamDeactivation is the data of team.deactivated events.
+type WebhookTeamDeactivation struct {
+	TeamName string `json:"team_name"`
+	DeactivateResult
+}
@@ -2,6 +2,8 @@
 package mapper
 
 import (
+	"time"
+
 	"assigning-reviewers-for-pr/internal/entities"
 	oapi "assigning-reviewers-for-pr/internal/oapi"
 )
@@ -10,18 +12,46 @@ import (
 func FromOAPITeam(src oapi.Team) entities.Team {
 	members := make([]entities.User, 0, len(src.Members))
 	for _, m := range src.Members {
-		members = append(members, entities.User{
-			ID:       m.UserId,
-			Username: m.Username,
-			TeamName: src.TeamName,
-			IsActive: m.IsActive,
-		})
+		members = append(members, FromOAPITeamMember(src.TeamName, m))
 	}
 
-	return entities.Team{
+	team := entities.Team{
 		Name:    src.TeamName,
 		Members: members,
 	}
+	if src.ParentTeamName != nil {
+		team.Parent = *src.ParentTeamName
+	}
+	return team
+}
+
+// FromOAPITeamMember builds a member of teamName from transport DTO.
+func FromOAPITeamMember(teamName string, src oapi.TeamMember) entities.User {
+	member := entities.User{
+		ID:       src.UserId,
+		Username: src.Username,
+		TeamName: teamName,
+		IsActive: src.IsActive,
+	}
+	if src.Role != nil {
+		member.Role = entities.Role(*src.Role)
+	}
+	return member
+}
+
+// ToOAPIMembershipResult maps a team membership change to transport model.
+func ToOAPIMembershipResult(res entities.MembershipResult) oapi.MembershipResult {
+	out := oapi.MembershipResult{
+		Team:       ToOAPITeam(res.Team),
+		UserId:     res.UserID,
+		Reassigned: res.Reassigned,
+		Removed:    res.Removed,
+	}
+	if res.FromTeam != "" {
+		from := res.FromTeam
+		out.FromTeamName = &from
+	}
+	return out
 }
 
 // ToOAPITeam maps entities.Team to transport model.
@@ -32,28 +62,331 @@ func ToOAPITeam(team entities.Team) oapi.Team {
 			UserId:   m.ID,
 			Username: m.Username,
 			IsActive: m.IsActive,
+			Role:     toOAPIRole(m.Role),
 		})
 	}
 
-	return oapi.Team{
+	res := oapi.Team{
 		TeamName: team.Name,
 		Members:  members,
 	}
+	if team.Parent != "" {
+		res.ParentTeamName = &team.Parent
+	}
+	if team.Descendants != nil {
+		descendants := make([]oapi.Team, 0, len(team.Descendants))
+		for _, d := range team.Descendants {
+			descendants = append(descendants, ToOAPITeam(d))
+		}
+		res.Descendants = &descendants
+	}
+	return res
+}
+
+// ToOAPITeamSummaries maps team listing entries to transport slice.
+func ToOAPITeamSummaries(teams []entities.TeamSummary) []oapi.TeamSummary {
+	res := make([]oapi.TeamSummary, 0, len(teams))
+	for _, t := range teams {
+		summary := oapi.TeamSummary{
+			TeamName:           t.Name,
+			MembersCount:       t.Members,
+			ActiveMembersCount: t.ActiveMembers,
+		}
+		if t.Parent != "" {
+			parent := t.Parent
+			summary.ParentTeamName = &parent
+		}
+		res = append(res, summary)
+	}
+	return res
+}
+
+// FromOAPITeamPolicy builds an entities.TeamPolicy from transport DTO.
+func FromOAPITeamPolicy(src oapi.TeamPolicy) entities.TeamPolicy {
+	policy := entities.TeamPolicy{
+		TeamName:          src.TeamName,
+		ReviewerCount:     src.ReviewerCount,
+		ExcludeAuthorTeam: src.ExcludeAuthorTeam,
+	}
+	if src.RequireSenior != nil {
+		policy.RequireSenior = *src.RequireSenior
+	}
+	if src.SelectionMode != nil {
+		policy.SelectionMode = entities.SelectionMode(*src.SelectionMode)
+	}
+	if src.FallbackTeams != nil {
+		policy.FallbackTeams = *src.FallbackTeams
+	}
+	if m := src.MergePolicy; m != nil {
+		if m.MinReviewers != nil {
+			policy.Merge.MinReviewers = *m.MinReviewers
+		}
+		if m.RequireActiveReviewers != nil {
+			policy.Merge.RequireActiveReviewers = *m.RequireActiveReviewers
+		}
+		if m.MinAgeSeconds != nil {
+			policy.Merge.MinAge = time.Duration(*m.MinAgeSeconds) * time.Second
+		}
+	}
+	return policy
+}
+
+// ToOAPITeamPolicy maps entities.TeamPolicy to transport model.
+func ToOAPITeamPolicy(policy entities.TeamPolicy) oapi.TeamPolicy {
+	res := oapi.TeamPolicy{
+		TeamName:          policy.TeamName,
+		ReviewerCount:     policy.ReviewerCount,
+		ExcludeAuthorTeam: policy.ExcludeAuthorTeam,
+		RequireSenior:     &policy.RequireSenior,
+	}
+	if policy.SelectionMode != "" {
+		mode := oapi.SelectionMode(policy.SelectionMode)
+		res.SelectionMode = &mode
+	}
+	if len(policy.FallbackTeams) > 0 {
+		fallbacks := append([]string(nil), policy.FallbackTeams...)
+		res.FallbackTeams = &fallbacks
+	}
+	minAge := int64(policy.Merge.MinAge / time.Second)
+	res.MergePolicy = &oapi.MergePolicy{
+		MinReviewers:           &policy.Merge.MinReviewers,
+		RequireActiveReviewers: &policy.Merge.RequireActiveReviewers,
+		MinAgeSeconds:          &minAge,
+	}
+	return res
+}
+
+// FromOAPIOwnershipRules builds ownership rules from transport DTOs.
+func FromOAPIOwnershipRules(src []oapi.OwnershipRule) []entities.OwnershipRule {
+	rules := make([]entities.OwnershipRule, 0, len(src))
+	for _, r := range src {
+		owners := make([]entities.Owner, 0, len(r.Owners))
+		for _, o := range r.Owners {
+			owners = append(owners, entities.Owner{Kind: entities.OwnerKind(o.Kind), ID: o.Id})
+		}
+		rules = append(rules, entities.OwnershipRule{Pattern: r.Pattern, Owners: owners})
+	}
+	return rules
+}
+
+// ToOAPIOwnershipRules maps ownership rules to transport models.
+func ToOAPIOwnershipRules(rules []entities.OwnershipRule) []oapi.OwnershipRule {
+	res := make([]oapi.OwnershipRule, 0, len(rules))
+	for _, r := range rules {
+		owners := make([]oapi.CodeOwner, 0, len(r.Owners))
+		for _, o := range r.Owners {
+			owners = append(owners, oapi.CodeOwner{Kind: oapi.CodeOwnerKind(o.Kind), Id: o.ID})
+		}
+		res = append(res, oapi.OwnershipRule{Pattern: r.Pattern, Owners: owners})
+	}
+	return res
+}
+
+// ToOAPIPairingRule maps a pairing rule to transport model.
+func ToOAPIPairingRule(rule entities.PairingRule) oapi.PairingRule {
+	return oapi.PairingRule{
+		Id:         rule.ID,
+		Kind:       oapi.PairingRuleKind(rule.Kind),
+		AuthorId:   rule.AuthorID,
+		ReviewerId: rule.ReviewerID,
+	}
+}
+
+// ToOAPIPairingRules maps pairing rules to transport models.
+func ToOAPIPairingRules(rules []entities.PairingRule) []oapi.PairingRule {
+	res := make([]oapi.PairingRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, ToOAPIPairingRule(r))
+	}
+	return res
+}
+
+// ToOAPIWebhook maps a webhook subscription to transport model without its secret.
+func ToOAPIWebhook(sub entities.WebhookSubscription) oapi.WebhookSubscription {
+	events := make([]oapi.WebhookEvent, 0, len(sub.Events))
+	for _, e := range sub.Events {
+		events = append(events, oapi.WebhookEvent(e))
+	}
+	return oapi.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		Events:    events,
+		CreatedAt: sub.CreatedAt,
+	}
+}
+
+// ToOAPIWebhooks maps webhook subscriptions to transport models.
+func ToOAPIWebhooks(subs []entities.WebhookSubscription) []oapi.WebhookSubscription {
+	res := make([]oapi.WebhookSubscription, 0, len(subs))
+	for _, sub := range subs {
+		res = append(res, ToOAPIWebhook(sub))
+	}
+	return res
+}
+
+// ToOAPIWebhookDeliveries maps webhook deliveries to transport models.
+func ToOAPIWebhookDeliveries(deliveries []entities.WebhookDelivery) []oapi.WebhookDelivery {
+	res := make([]oapi.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, oapi.WebhookDelivery{
+			Id:             d.ID,
+			SubscriptionId: d.SubscriptionID,
+			EventId:        d.EventID,
+			Event:          oapi.WebhookEvent(d.Event),
+			Status:         oapi.WebhookDeliveryStatus(d.Status),
+			Attempts:       d.Attempts,
+			ResponseCode:   d.ResponseCode,
+			LastError:      d.LastError,
+			NextAttemptAt:  d.NextAttemptAt,
+			LastAttemptAt:  d.LastAttemptAt,
+			DeliveredAt:    d.DeliveredAt,
+			CreatedAt:      d.CreatedAt,
+		})
+	}
+	return res
+}
+
+// ToOAPICodeHostSyncs maps code host sync states to transport models.
+func ToOAPICodeHostSyncs(syncs []entities.CodeHostSync) []oapi.CodeHostSync {
+	res := make([]oapi.CodeHostSync, 0, len(syncs))
+	for _, s := range syncs {
+		res = append(res, oapi.CodeHostSync{
+			PrId:            s.PRID,
+			Status:          oapi.CodeHostSyncStatus(s.Status),
+			Attempts:        s.Attempts,
+			SyncedReviewers: append(make([]string, 0, len(s.SyncedReviewers)), s.SyncedReviewers...),
+			ResponseCode:    s.ResponseCode,
+			LastError:       s.LastError,
+			NextAttemptAt:   s.NextAttemptAt,
+			LastAttemptAt:   s.LastAttemptAt,
+			SyncedAt:        s.SyncedAt,
+			UpdatedAt:       s.UpdatedAt,
+		})
+	}
+	return res
 }
 
 // ToOAPIUser maps entities.User to transport model.
 func ToOAPIUser(u entities.User) oapi.User {
-	return oapi.User{
+	res := oapi.User{
 		UserId:   u.ID,
 		Username: u.Username,
 		TeamName: u.TeamName,
 		IsActive: u.IsActive,
+		Role:     toOAPIRole(u.Role),
 	}
+	if u.Skills != nil {
+		skills := append([]string(nil), u.Skills...)
+		res.Skills = &skills
+	}
+	if u.Schedule != nil {
+		schedule := ToOAPIWorkSchedule(*u.Schedule)
+		res.Schedule = &schedule
+	}
+	if u.Capacity != nil {
+		res.MaxOpenReviews = u.Capacity.MaxOpenReviews
+		weight := u.Capacity.Weight
+		res.ReviewWeight = &weight
+	}
+	return res
+}
+
+// ToOAPIUserList maps a slice of entities.User to transport slice.
+func ToOAPIUserList(users []entities.User) []oapi.User {
+	res := make([]oapi.User, 0, len(users))
+	for _, u := range users {
+		res = append(res, ToOAPIUser(u))
+	}
+	return res
+}
+
+// toOAPIRole returns nil for an unknown (not loaded) role.
+func toOAPIRole(r entities.Role) *oapi.Role {
+	if r == "" {
+		return nil
+	}
+	res := oapi.Role(r)
+	return &res
+}
+
+// FromOAPIReviewCapacity builds a review capacity from transport fields, defaulting an omitted weight.
+func FromOAPIReviewCapacity(maxOpenReviews *int, weight *float64) entities.ReviewCapacity {
+	res := entities.DefaultReviewCapacity()
+	res.MaxOpenReviews = maxOpenReviews
+	if weight != nil {
+		res.Weight = *weight
+	}
+	return res
+}
+
+// FromOAPIWorkSchedule builds a work schedule from transport DTO.
+func FromOAPIWorkSchedule(src oapi.WorkSchedule) (entities.WorkSchedule, error) {
+	start, err := entities.ParseClock(src.WorkStart)
+	if err != nil {
+		return entities.WorkSchedule{}, err
+	}
+	end, err := entities.ParseClock(src.WorkEnd)
+	if err != nil {
+		return entities.WorkSchedule{}, err
+	}
+	days := make([]time.Weekday, 0, len(src.WorkDays))
+	for _, d := range src.WorkDays {
+		day, err := entities.ParseWeekday(string(d))
+		if err != nil {
+			return entities.WorkSchedule{}, err
+		}
+		days = append(days, day)
+	}
+	return entities.WorkSchedule{
+		TimeZone:    src.TimeZone,
+		StartMinute: start,
+		EndMinute:   end,
+		Days:        days,
+	}, nil
+}
+
+// ToOAPIWorkSchedule maps a work schedule to transport model.
+func ToOAPIWorkSchedule(s entities.WorkSchedule) oapi.WorkSchedule {
+	days := make([]oapi.WorkScheduleWorkDays, 0, len(s.Days))
+	for _, d := range s.Days {
+		days = append(days, oapi.WorkScheduleWorkDays(entities.WeekdayName(d)))
+	}
+	return oapi.WorkSchedule{
+		TimeZone:  s.TimeZone,
+		WorkStart: entities.FormatClock(s.StartMinute),
+		WorkEnd:   entities.FormatClock(s.EndMinute),
+		WorkDays:  days,
+	}
+}
+
+// ToOAPIUnavailability maps an out-of-office period to transport model.
+func ToOAPIUnavailability(period entities.Unavailability) oapi.Unavailability {
+	res := oapi.Unavailability{
+		Id:              period.ID,
+		UserId:          period.UserID,
+		StartsAt:        period.StartsAt,
+		EndsAt:          period.EndsAt,
+		ReassignReviews: period.ReassignReviews,
+	}
+	if period.Reason != "" {
+		reason := period.Reason
+		res.Reason = &reason
+	}
+	return res
+}
+
+// ToOAPIUnavailabilityList maps out-of-office periods to transport models.
+func ToOAPIUnavailabilityList(periods []entities.Unavailability) []oapi.Unavailability {
+	res := make([]oapi.Unavailability, 0, len(periods))
+	for _, p := range periods {
+		res = append(res, ToOAPIUnavailability(p))
+	}
+	return res
 }
 
 // ToOAPIPull maps entities.PullRequest to transport model.
 func ToOAPIPull(pr entities.PullRequest) oapi.PullRequest {
-	return oapi.PullRequest{
+	res := oapi.PullRequest{
 		PullRequestId:     pr.ID,
 		PullRequestName:   pr.Name,
 		AuthorId:          pr.AuthorID,
@@ -61,7 +394,98 @@ func ToOAPIPull(pr entities.PullRequest) oapi.PullRequest {
 		AssignedReviewers: pr.Reviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
 	}
+	if len(pr.Labels) > 0 {
+		labels := append([]string(nil), pr.Labels...)
+		res.Labels = &labels
+	}
+	if len(pr.FallbackTeams) > 0 {
+		fallbacks := append([]string(nil), pr.FallbackTeams...)
+		res.FallbackTeams = &fallbacks
+	}
+	if len(pr.Reviews) > 0 {
+		reviews := make([]oapi.Review, 0, len(pr.Reviews))
+		for _, r := range pr.Reviews {
+			reviews = append(reviews, oapi.Review{
+				UserId:      r.ReviewerID,
+				State:       oapi.ReviewState(r.State),
+				AssignedAt:  r.AssignedAt,
+				SubmittedAt: r.SubmittedAt,
+			})
+		}
+		res.Reviews = &reviews
+	}
+	return res
+}
+
+// ToOAPIPullList maps a slice of entities.PullRequest to transport slice.
+func ToOAPIPullList(prs []entities.PullRequest) []oapi.PullRequest {
+	res := make([]oapi.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		res = append(res, ToOAPIPull(pr))
+	}
+	return res
+}
+
+// ToOAPIAssignmentPreview maps an assignment dry run to transport model.
+func ToOAPIAssignmentPreview(src entities.AssignmentPreview) oapi.AssignmentPreview {
+	owners := make(map[string]bool, len(src.CodeOwners))
+	for _, o := range src.CodeOwners {
+		owners[o.UserID] = true
+	}
+	toCandidate := func(c entities.Candidate) oapi.AssignmentCandidate {
+		res := oapi.AssignmentCandidate{
+			UserId:      c.UserID,
+			AssignCnt:   int(c.AssignCnt),
+			OpenReviews: int(c.OpenReviews),
+			CodeOwner:   owners[c.UserID],
+			Role:        toOAPIRole(c.Role),
+		}
+		weight := c.ReviewWeight()
+		res.ReviewWeight = &weight
+		if len(c.Skills) > 0 {
+			skills := append([]string(nil), c.Skills...)
+			res.Skills = &skills
+		}
+		return res
+	}
+
+	candidates := make([]oapi.AssignmentCandidate, 0, len(src.Required)+len(src.CodeOwners)+len(src.Candidates))
+	for _, c := range src.Required {
+		candidate := toCandidate(c)
+		required := true
+		candidate.Required = &required
+		candidates = append(candidates, candidate)
+	}
+	for _, c := range src.CodeOwners {
+		candidates = append(candidates, toCandidate(c))
+	}
+	for _, c := range src.Candidates {
+		if !owners[c.UserID] {
+			candidates = append(candidates, toCandidate(c))
+		}
+	}
+
+	excluded := make([]oapi.ExcludedCandidate, 0, len(src.Excluded))
+	for _, e := range src.Excluded {
+		excluded = append(excluded, oapi.ExcludedCandidate{UserId: e.UserID, Reason: oapi.ExcludedCandidateReason(e.Reason)})
+	}
+
+	reviewers := make([]string, len(src.Reviewers))
+	copy(reviewers, src.Reviewers)
+
+	res := oapi.AssignmentPreview{
+		Policy:     ToOAPITeamPolicy(src.Policy),
+		Candidates: candidates,
+		Excluded:   excluded,
+		Reviewers:  reviewers,
+	}
+	if len(src.FallbackTeams) > 0 {
+		fallbacks := append([]string(nil), src.FallbackTeams...)
+		res.FallbackTeams = &fallbacks
+	}
+	return res
 }
 
 // ToOAPIPullShort maps entities.PullRequestShort to transport model.
@@ -71,7 +495,16 @@ func ToOAPIPullShort(pr entities.PullRequestShort) oapi.PullRequestShort {
 		PullRequestName: pr.Name,
 		AuthorId:        pr.AuthorID,
 		Status:          oapi.PullRequestShortStatus(pr.Status),
+		ReviewState:     toOAPIReviewState(pr.ReviewState),
+	}
+}
+
+func toOAPIReviewState(s entities.ReviewState) *oapi.ReviewState {
+	if s == "" {
+		return nil
 	}
+	res := oapi.ReviewState(s)
+	return &res
 }
 
 // ToOAPIPullShortList maps a slice of entities.PullRequestShort to transport slice.
@@ -146,15 +579,30 @@ func ToOAPIStatsSummary(src entities.StatsSummary) oapi.StatsSummary {
 
 // ToOAPIReviewerStats maps per-user stats to transport DTO.
 func ToOAPIReviewerStats(src entities.ReviewerStats) oapi.ReviewerStats {
-	userID, assign, openCnt, mergedCnt := src.UserID, src.AssignCnt, src.OpenPRCnt, src.MergedPRCnt
+	userID, assign, openCnt, mergedCnt, closedCnt := src.UserID, src.AssignCnt, src.OpenPRCnt, src.MergedPRCnt, src.ClosedPRCnt
 	recent := ToOAPIPullShortList(src.RecentPRs)
-	return oapi.ReviewerStats{
-		UserId:      &userID,
-		AssignCnt:   &assign,
-		OpenPrCnt:   &openCnt,
-		MergedPrCnt: &mergedCnt,
-		RecentPrs:   &recent,
+	res := oapi.ReviewerStats{
+		UserId:         &userID,
+		AssignCnt:      &assign,
+		OpenPrCnt:      &openCnt,
+		MergedPrCnt:    &mergedCnt,
+		ClosedPrCnt:    &closedCnt,
+		RecentPrs:      &recent,
+		LocalTime:      src.LocalTime,
+		InWorkingHours: &src.InWorkingHours,
+		MaxOpenReviews: src.Capacity.MaxOpenReviews,
+		CapacityUsage:  src.CapacityUsage,
+		AtCapacity:     &src.AtCapacity,
 	}
+	if src.Capacity.Weight > 0 {
+		weight := src.Capacity.Weight
+		res.ReviewWeight = &weight
+	}
+	if src.Schedule.TimeZone != "" {
+		tz := src.Schedule.TimeZone
+		res.TimeZone = &tz
+	}
+	return res
 }
 
 // ToOAPIPRStats maps PR stats to transport DTO.
@@ -166,12 +614,17 @@ func ToOAPIPRStats(src entities.PRStats) oapi.PRStats {
 
 	reassignments := make([]oapi.ReassignmentEvent, 0, len(src.Reassignments))
 	for _, r := range src.Reassignments {
-		oldID, newID := r.OldReviewerID, r.NewReviewerID
-		reassignments = append(reassignments, oapi.ReassignmentEvent{
-			OldReviewerId: &oldID,
-			NewReviewerId: newID,
+		ev := oapi.ReassignmentEvent{
+			OldReviewerId: r.OldReviewerID,
+			NewReviewerId: r.NewReviewerID,
+			FallbackTeam:  r.FallbackTeam,
 			ChangedAt:     &r.ChangedAt,
-		})
+		}
+		if r.Reason != nil {
+			reason := oapi.ReassignmentEventReason(*r.Reason)
+			ev.Reason = &reason
+		}
+		reassignments = append(reassignments, ev)
 	}
 
 	return oapi.PRStats{
@@ -18,46 +18,268 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for CodeHostSyncStatus.
+const (
+	CodeHostSyncStatusFailed  CodeHostSyncStatus = "failed"
+	CodeHostSyncStatusPending CodeHostSyncStatus = "pending"
+	CodeHostSyncStatusSkipped CodeHostSyncStatus = "skipped"
+	CodeHostSyncStatusSynced  CodeHostSyncStatus = "synced"
+)
+
+// Defines values for CodeHostWebhookResultStatus.
+const (
+	Applied CodeHostWebhookResultStatus = "applied"
+	Ignored CodeHostWebhookResultStatus = "ignored"
+)
+
+// Defines values for CodeOwnerKind.
+const (
+	CodeOwnerKindTeam CodeOwnerKind = "team"
+	CodeOwnerKindUser CodeOwnerKind = "user"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED   ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	AUTHORHASOPENPRS  ErrorResponseErrorCode = "AUTHOR_HAS_OPEN_PRS"
+	INVALIDSTATUS     ErrorResponseErrorCode = "INVALID_STATUS"
+	MEMBEROFOTHERTEAM ErrorResponseErrorCode = "MEMBER_OF_OTHER_TEAM"
+	MERGEBLOCKED      ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE       ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED       ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND          ErrorResponseErrorCode = "NOT_FOUND"
+	NOTTEAMMEMBER     ErrorResponseErrorCode = "NOT_TEAM_MEMBER"
+	PAIRINGEXISTS     ErrorResponseErrorCode = "PAIRING_EXISTS"
+	PAIRINGVIOLATION  ErrorResponseErrorCode = "PAIRING_VIOLATION"
+	PREXISTS          ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED          ErrorResponseErrorCode = "PR_MERGED"
+	SENIORREQUIRED    ErrorResponseErrorCode = "SENIOR_REQUIRED"
+	TEAMEXISTS        ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED      ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for ExcludedCandidateReason.
+const (
+	AtCapacity  ExcludedCandidateReason = "at_capacity"
+	Author      ExcludedCandidateReason = "author"
+	Blocked     ExcludedCandidateReason = "blocked"
+	Inactive    ExcludedCandidateReason = "inactive"
+	Unavailable ExcludedCandidateReason = "unavailable"
+)
+
+// Defines values for PairingRuleKind.
+const (
+	PairingRuleKindBlock   PairingRuleKind = "block"
+	PairingRuleKindRequire PairingRuleKind = "require"
 )
 
 // Defines values for PRStatsStatus.
 const (
+	PRStatsStatusCLOSED PRStatsStatus = "CLOSED"
+	PRStatsStatusDRAFT  PRStatsStatus = "DRAFT"
 	PRStatsStatusMERGED PRStatsStatus = "MERGED"
 	PRStatsStatusOPEN   PRStatsStatus = "OPEN"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReassignmentEventReason.
+const (
+	SlaExpired ReassignmentEventReason = "sla_expired"
+)
+
+// Defines values for ReviewState.
+const (
+	ReviewStateApproved         ReviewState = "approved"
+	ReviewStateChangesRequested ReviewState = "changes_requested"
+	ReviewStateDeclined         ReviewState = "declined"
+	ReviewStatePending          ReviewState = "pending"
+)
+
+// Defines values for Role.
+const (
+	Junior Role = "junior"
+	Lead   Role = "lead"
+	Senior Role = "senior"
+)
+
+// Defines values for SelectionMode.
+const (
+	LeastLoaded  SelectionMode = "least_loaded"
+	LeastOpen    SelectionMode = "least_open"
+	Random       SelectionMode = "random"
+	RoundRobin   SelectionMode = "round_robin"
+	WorkingHours SelectionMode = "working_hours"
+)
+
 // Defines values for StatusStatStatus.
 const (
+	StatusStatStatusCLOSED StatusStatStatus = "CLOSED"
+	StatusStatStatusDRAFT  StatusStatStatus = "DRAFT"
 	StatusStatStatusMERGED StatusStatStatus = "MERGED"
 	StatusStatStatusOPEN   StatusStatStatus = "OPEN"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEvent.
+const (
+	PrCreated          WebhookEvent = "pr.created"
+	PrMerged           WebhookEvent = "pr.merged"
+	ReviewerAssigned   WebhookEvent = "reviewer.assigned"
+	ReviewerReassigned WebhookEvent = "reviewer.reassigned"
+	TeamDeactivated    WebhookEvent = "team.deactivated"
+)
+
+// Defines values for WorkScheduleWorkDays.
+const (
+	Fri WorkScheduleWorkDays = "fri"
+	Mon WorkScheduleWorkDays = "mon"
+	Sat WorkScheduleWorkDays = "sat"
+	Sun WorkScheduleWorkDays = "sun"
+	Thu WorkScheduleWorkDays = "thu"
+	Tue WorkScheduleWorkDays = "tue"
+	Wed WorkScheduleWorkDays = "wed"
+)
+
+// Defines values for GetIntegrationsSyncStatusParamsStatus.
+const (
+	GetIntegrationsSyncStatusParamsStatusFailed  GetIntegrationsSyncStatusParamsStatus = "failed"
+	GetIntegrationsSyncStatusParamsStatusPending GetIntegrationsSyncStatusParamsStatus = "pending"
+	GetIntegrationsSyncStatusParamsStatusSkipped GetIntegrationsSyncStatusParamsStatus = "skipped"
+	GetIntegrationsSyncStatusParamsStatusSynced  GetIntegrationsSyncStatusParamsStatus = "synced"
+)
+
+// Defines values for PostPairingAddJSONBodyKind.
+const (
+	PostPairingAddJSONBodyKindBlock   PostPairingAddJSONBodyKind = "block"
+	PostPairingAddJSONBodyKindRequire PostPairingAddJSONBodyKind = "require"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusCLOSED GetPullRequestListParamsStatus = "CLOSED"
+	GetPullRequestListParamsStatusDRAFT  GetPullRequestListParamsStatus = "DRAFT"
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
 // Defines values for GetStatsSummaryParamsStatus.
 const (
+	CLOSED GetStatsSummaryParamsStatus = "CLOSED"
+	DRAFT  GetStatsSummaryParamsStatus = "DRAFT"
 	MERGED GetStatsSummaryParamsStatus = "MERGED"
 	OPEN   GetStatsSummaryParamsStatus = "OPEN"
 )
 
+// Defines values for GetWebhooksDeliveriesParamsStatus.
+const (
+	GetWebhooksDeliveriesParamsStatusDelivered GetWebhooksDeliveriesParamsStatus = "delivered"
+	GetWebhooksDeliveriesParamsStatusFailed    GetWebhooksDeliveriesParamsStatus = "failed"
+	GetWebhooksDeliveriesParamsStatusPending   GetWebhooksDeliveriesParamsStatus = "pending"
+)
+
+// AssignmentCandidate defines model for AssignmentCandidate.
+type AssignmentCandidate struct {
+	// AssignCnt Сколько раз пользователь назначался ревьюером за всё время
+	AssignCnt int `json:"assign_cnt"`
+
+	// CodeOwner Владелец изменённых файлов, занимает место первым
+	CodeOwner bool `json:"code_owner"`
+
+	// OpenReviews Число открытых PR, где пользователь ревьюер
+	OpenReviews int `json:"open_reviews"`
+
+	// Required Обязательный ревьювер по правилу пары, назначается всегда
+	Required *bool `json:"required,omitempty"`
+
+	// ReviewWeight Вес ревьювера при выборе (1 — полная ставка)
+	ReviewWeight *float64 `json:"review_weight,omitempty"`
+
+	// Role Сеньорность пользователя; lead считается senior-ревьювером. Без значения при создании команды — junior (у существующего пользователя роль не меняется)
+	Role   *Role     `json:"role,omitempty"`
+	Skills *[]string `json:"skills,omitempty"`
+	UserId string    `json:"user_id"`
+}
+
+// AssignmentPreview defines model for AssignmentPreview.
+type AssignmentPreview struct {
+	Candidates []AssignmentCandidate `json:"candidates"`
+	Excluded   []ExcludedCandidate   `json:"excluded"`
+
+	// FallbackTeams Резервные команды, из которых добраны ревьюеры
+	FallbackTeams *[]string  `json:"fallback_teams,omitempty"`
+	Policy        TeamPolicy `json:"policy"`
+
+	// Reviewers user_id ревьюеров, которых назначил бы /pullRequest/create
+	Reviewers []string `json:"reviewers"`
+}
+
+// CodeHostSync defines model for CodeHostSync.
+type CodeHostSync struct {
+	Attempts      int        `json:"attempts"`
+	LastAttemptAt *time.Time `json:"last_attempt_at"`
+
+	// LastError Ошибка последней попытки или причина пропуска
+	LastError     *string   `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	PrId          string    `json:"pr_id"`
+
+	// ResponseCode HTTP-код последнего ответа API хостинга
+	ResponseCode *int `json:"response_code"`
+
+	// Status pending — ждёт отправки или повтора, failed — попытки исчерпаны, skipped — PR не из настроенного репозитория или уже не открыт
+	Status CodeHostSyncStatus `json:"status"`
+
+	// SyncedReviewers user_id ревьюверов, запрошенных на стороне хостинга последней успешной отправкой
+	SyncedReviewers []string   `json:"synced_reviewers"`
+	SyncedAt        *time.Time `json:"synced_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// CodeHostSyncStatus pending — ждёт отправки или повтора, failed — попытки исчерпаны, skipped — PR не из настроенного репозитория или уже не открыт
+type CodeHostSyncStatus string
+
+// CodeHostWebhookResult defines model for CodeHostWebhookResult.
+type CodeHostWebhookResult struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+
+	// Status ignored — событие не отслеживается или уже было применено
+	Status CodeHostWebhookResultStatus `json:"status"`
+}
+
+// CodeHostWebhookResultStatus ignored — событие не отслеживается или уже было применено
+type CodeHostWebhookResultStatus string
+
+// CodeOwner defines model for CodeOwner.
+type CodeOwner struct {
+	// Id user_id для kind=user, team_name для kind=team
+	Id   string        `json:"id"`
+	Kind CodeOwnerKind `json:"kind"`
+}
+
+// CodeOwnerKind defines model for CodeOwner.Kind.
+type CodeOwnerKind string
+
 // DeactivateResult defines model for DeactivateResult.
 type DeactivateResult struct {
 	DeactivatedUsers *int `json:"deactivated_users,omitempty"`
@@ -76,6 +298,62 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExcludedCandidate defines model for ExcludedCandidate.
+type ExcludedCandidate struct {
+	Reason ExcludedCandidateReason `json:"reason"`
+	UserId string                  `json:"user_id"`
+}
+
+// ExcludedCandidateReason defines model for ExcludedCandidate.Reason.
+type ExcludedCandidateReason string
+
+// MembershipResult defines model for MembershipResult.
+type MembershipResult struct {
+	// FromTeamName Команда, из которой ушёл пользователь; отсутствует, если он не состоял в команде
+	FromTeamName *string `json:"from_team_name,omitempty"`
+
+	// Reassigned Открытые ревью пользователя, переданные другим ревьюверам
+	Reassigned int `json:"reassigned"`
+
+	// Removed Открытые ревью пользователя, снятые без замены
+	Removed int    `json:"removed"`
+	Team    Team   `json:"team"`
+	UserId  string `json:"user_id"`
+}
+
+// MergePolicy Условия merge PR авторов команды; нулевые значения отключают проверку
+type MergePolicy struct {
+	// MinAgeSeconds Сколько секунд PR должен провисеть открытым до merge
+	MinAgeSeconds *int64 `json:"min_age_seconds,omitempty"`
+
+	// MinReviewers Минимальное число назначенных ревьюверов
+	MinReviewers *int `json:"min_reviewers,omitempty"`
+
+	// RequireActiveReviewers Запрещать merge, пока среди назначенных ревьюверов есть неактивные
+	RequireActiveReviewers *bool `json:"require_active_reviewers,omitempty"`
+}
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	Owners []CodeOwner `json:"owners"`
+
+	// Pattern Шаблон пути в синтаксисе CODEOWNERS
+	Pattern string `json:"pattern"`
+}
+
+// PairingRule defines model for PairingRule.
+type PairingRule struct {
+	AuthorId string `json:"author_id"`
+	Id       int64  `json:"id"`
+
+	// Kind block — никогда не назначать reviewer_id на PR автора, require — всегда назначать
+	Kind       PairingRuleKind `json:"kind"`
+	ReviewerId string          `json:"reviewer_id"`
+}
+
+// PairingRuleKind block — никогда не назначать reviewer_id на PR автора, require — всегда назначать
+type PairingRuleKind string
+
 // PRStat defines model for PRStat.
 type PRStat struct {
 	AssignCnt *int64  `json:"assign_cnt,omitempty"`
@@ -101,13 +379,21 @@ type PRStatsStatus string
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackTeams Резервные команды, из которых взяты текущие ревьюверы
+	FallbackTeams   *[]string  `json:"fallback_teams,omitempty"`
+	Labels          *[]string  `json:"labels,omitempty"`
+	MergedAt        *time.Time `json:"mergedAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// Reviews Состояние ревью каждого назначенного ревьювера
+	Reviews *[]Review         `json:"reviews,omitempty"`
+	Status  PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -115,31 +401,80 @@ type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// ReviewState Решение ревьювера; pending — решение ещё не отправлено
+	ReviewState *ReviewState           `json:"review_state,omitempty"`
+	Status      PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
-// ReassignmentEvent defines model for ReassignmentEvent.
+// ReassignmentEvent Смена ревьювера; без old_reviewer_id — ревьювер добавлен, без new_reviewer_id — снят
 type ReassignmentEvent struct {
-	ChangedAt     *time.Time `json:"changed_at,omitempty"`
-	NewReviewerId *string    `json:"new_reviewer_id"`
-	OldReviewerId *string    `json:"old_reviewer_id,omitempty"`
+	ChangedAt *time.Time `json:"changed_at,omitempty"`
+
+	// FallbackTeam Резервная команда, из которой взят новый ревьювер
+	FallbackTeam  *string `json:"fallback_team,omitempty"`
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId *string `json:"old_reviewer_id"`
+
+	// Reason Причина автоматической смены (sla_expired — ревью дольше ASSIGNMENT_REVIEW_SLA в pending); отсутствует — смена вручную
+	Reason *ReassignmentEventReason `json:"reason,omitempty"`
+}
+
+// ReassignmentEventReason Причина автоматической смены (sla_expired — ревью дольше ASSIGNMENT_REVIEW_SLA в pending); отсутствует — смена вручную
+type ReassignmentEventReason string
+
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// State Решение ревьювера; pending — решение ещё не отправлено
+	State       ReviewState `json:"state"`
+	SubmittedAt *time.Time  `json:"submitted_at"`
+	UserId      string      `json:"user_id"`
 }
 
+// ReviewState Решение ревьювера; pending — решение ещё не отправлено
+type ReviewState string
+
 // ReviewerStats defines model for ReviewerStats.
 type ReviewerStats struct {
-	AssignCnt   *int64              `json:"assign_cnt,omitempty"`
-	MergedPrCnt *int64              `json:"merged_pr_cnt,omitempty"`
-	OpenPrCnt   *int64              `json:"open_pr_cnt,omitempty"`
-	RecentPrs   *[]PullRequestShort `json:"recent_prs,omitempty"`
-	UserId      *string             `json:"user_id,omitempty"`
+	AssignCnt *int64 `json:"assign_cnt,omitempty"`
+
+	// AtCapacity Лимит исчерпан, новые ревью не назначаются
+	AtCapacity *bool `json:"at_capacity,omitempty"`
+
+	// CapacityUsage Доля лимита, занятая открытыми ревью (open_pr_cnt / max_open_reviews)
+	CapacityUsage *float64 `json:"capacity_usage,omitempty"`
+
+	// InWorkingHours Находится ли ревьювер сейчас в рабочих часах
+	InWorkingHours *bool `json:"in_working_hours,omitempty"`
+
+	// LocalTime Текущее местное время ревьювера (со смещением его часового пояса)
+	LocalTime *time.Time `json:"local_time,omitempty"`
+
+	// MaxOpenReviews Максимум одновременных ревью открытых PR; отсутствует — без ограничения
+	MaxOpenReviews *int                `json:"max_open_reviews,omitempty"`
+	ClosedPrCnt    *int64              `json:"closed_pr_cnt,omitempty"`
+	MergedPrCnt    *int64              `json:"merged_pr_cnt,omitempty"`
+	OpenPrCnt      *int64              `json:"open_pr_cnt,omitempty"`
+	RecentPrs      *[]PullRequestShort `json:"recent_prs,omitempty"`
+	ReviewWeight   *float64            `json:"review_weight,omitempty"`
+	TimeZone       *string             `json:"time_zone,omitempty"`
+	UserId         *string             `json:"user_id,omitempty"`
 }
 
+// Role Сеньорность пользователя; lead считается senior-ревьювером. Без значения при создании команды — junior (у существующего пользователя роль не меняется)
+type Role string
+
+// SelectionMode Стратегия выбора ревьюеров
+type SelectionMode string
+
 // Stats defines model for Stats.
 type Stats struct {
 	ByPr     *[]PRStat     `json:"by_pr,omitempty"`
@@ -166,29 +501,94 @@ type StatusStatStatus string
 
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// Descendants Команды ниже по иерархии на всех уровнях с участниками; возвращается только по запросу
+	Descendants *[]Team      `json:"descendants,omitempty"`
+	Members     []TeamMember `json:"members"`
+
+	// ParentTeamName Родительская команда (например, департамент); отсутствует у команды верхнего уровня
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
+	IsActive bool `json:"is_active"`
+
+	// Role Сеньорность пользователя; lead считается senior-ревьювером. Без значения при создании команды — junior (у существующего пользователя роль не меняется)
+	Role     *Role  `json:"role,omitempty"`
 	UserId   string `json:"user_id"`
 	Username string `json:"username"`
 }
 
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	ActiveMembersCount int `json:"active_members_count"`
+	MembersCount       int `json:"members_count"`
+
+	// ParentTeamName Родительская команда; отсутствует у команды верхнего уровня
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// TeamPolicy defines model for TeamPolicy.
+type TeamPolicy struct {
+	// ExcludeAuthorTeam Назначать ревьюеров из других команд, а не из команды автора
+	ExcludeAuthorTeam bool `json:"exclude_author_team"`
+
+	// FallbackTeams Резервные команды по порядку; из них добираются ревьюеры, если в основном пуле не хватает кандидатов; резервная команда отдаёт ревьюеров и из своих подкоманд
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MergePolicy Условия merge PR авторов команды; нулевые значения отключают проверку
+	MergePolicy *MergePolicy `json:"merge_policy,omitempty"`
+
+	// RequireSenior Среди ревьюверов каждого PR автора из этой команды должен быть хотя бы один senior или lead
+	RequireSenior *bool `json:"require_senior,omitempty"`
+
+	// ReviewerCount Сколько ревьюеров назначать на PR автора из этой команды
+	ReviewerCount int `json:"reviewer_count"`
+
+	// SelectionMode Стратегия выбора ревьюеров
+	SelectionMode *SelectionMode `json:"selection_mode,omitempty"`
+	TeamName      string         `json:"team_name"`
+}
+
 // TeamStat defines model for TeamStat.
 type TeamStat struct {
 	AssignCnt *int64  `json:"assign_cnt,omitempty"`
 	TeamName  *string `json:"team_name,omitempty"`
 }
 
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	EndsAt time.Time `json:"ends_at"`
+	Id     int64     `json:"id"`
+	Reason *string   `json:"reason,omitempty"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя, когда период начнётся
+	ReassignReviews bool      `json:"reassign_reviews"`
+	StartsAt        time.Time `json:"starts_at"`
+	UserId          string    `json:"user_id"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум одновременных ревью открытых PR; отсутствует — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReviewWeight Относительный вес при выборе ревьюверов (1 — полная ставка, 0.5 — половина)
+	ReviewWeight *float64 `json:"review_weight,omitempty"`
+
+	// Role Сеньорность пользователя; lead считается senior-ревьювером. Без значения при создании команды — junior (у существующего пользователя роль не меняется)
+	Role     *Role         `json:"role,omitempty"`
+	Schedule *WorkSchedule `json:"schedule,omitempty"`
+
+	// Skills Навыки пользователя (нормализованные теги, сопоставляются с метками PR)
+	Skills   *[]string `json:"skills,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
 }
 
 // UserStat defines model for UserStat.
@@ -197,28 +597,243 @@ type UserStat struct {
 	UserId    *string `json:"user_id,omitempty"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+
+	// Event Тип доменного события
+	Event         WebhookEvent `json:"event"`
+	EventId       int64        `json:"event_id"`
+	Id            int64        `json:"id"`
+	LastAttemptAt *time.Time   `json:"last_attempt_at"`
+	LastError     *string      `json:"last_error"`
+	NextAttemptAt time.Time    `json:"next_attempt_at"`
+
+	// ResponseCode HTTP-код последнего ответа подписчика
+	ResponseCode *int `json:"response_code"`
+
+	// Status pending — ждёт первой или повторной попытки, failed — попытки исчерпаны
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryStatus pending — ждёт первой или повторной попытки, failed — попытки исчерпаны
+type WebhookDeliveryStatus string
+
+// WebhookEvent Тип доменного события
+type WebhookEvent string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time      `json:"created_at"`
+	Events    []WebhookEvent `json:"events"`
+	Id        int64          `json:"id"`
+	Url       string         `json:"url"`
+}
+
+// WorkSchedule defines model for WorkSchedule.
+type WorkSchedule struct {
+	// TimeZone Часовой пояс IANA, например Europe/Moscow
+	TimeZone string                 `json:"time_zone"`
+	WorkDays []WorkScheduleWorkDays `json:"work_days"`
+
+	// WorkEnd Конец рабочего дня по местному времени (HH:MM); раньше начала — ночная смена
+	WorkEnd string `json:"work_end"`
+
+	// WorkStart Начало рабочего дня по местному времени (HH:MM)
+	WorkStart string `json:"work_start"`
+}
+
+// WorkScheduleWorkDays defines model for WorkSchedule.WorkDays.
+type WorkScheduleWorkDays string
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int32
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// UserId Только события, касающиеся пользователя (автор PR или ревьювер)
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только события, касающиеся участников команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// LastEventId Продолжить после этого события; заголовок Last-Event-ID имеет приоритет
+	LastEventId *int64 `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+}
+
+// PostIntegrationsGithubWebhookJSONBody defines parameters for PostIntegrationsGithubWebhook.
+type PostIntegrationsGithubWebhookJSONBody = map[string]interface{}
+
+// PostIntegrationsGitlabWebhookJSONBody defines parameters for PostIntegrationsGitlabWebhook.
+type PostIntegrationsGitlabWebhookJSONBody = map[string]interface{}
+
+// GetIntegrationsSyncStatusParams defines parameters for GetIntegrationsSyncStatus.
+type GetIntegrationsSyncStatusParams struct {
+	// PrId Только этот PR
+	PrId *string `form:"pr_id,omitempty" json:"pr_id,omitempty"`
+
+	// Status Фильтр по статусу отправки
+	Status *GetIntegrationsSyncStatusParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество записей (по умолчанию 50, не больше 500)
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetIntegrationsSyncStatusParamsStatus defines parameters for GetIntegrationsSyncStatus.
+type GetIntegrationsSyncStatusParamsStatus string
+
+// PostOwnershipImportJSONBody defines parameters for PostOwnershipImport.
+type PostOwnershipImportJSONBody struct {
+	// Codeowners Содержимое CODEOWNERS (@user — пользователь, @org/team — команда)
+	Codeowners string `json:"codeowners"`
+}
+
+// PostOwnershipRulesJSONBody defines parameters for PostOwnershipRules.
+type PostOwnershipRulesJSONBody struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+// PostPairingAddJSONBody defines parameters for PostPairingAdd.
+type PostPairingAddJSONBody struct {
+	AuthorId   string                     `json:"author_id"`
+	Kind       PostPairingAddJSONBodyKind `json:"kind"`
+	ReviewerId string                     `json:"reviewer_id"`
+}
+
+// PostPairingAddJSONBodyKind defines parameters for PostPairingAdd.
+type PostPairingAddJSONBodyKind string
+
+// PostPairingDeleteJSONBody defines parameters for PostPairingDelete.
+type PostPairingDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetPairingRulesParams defines parameters for GetPairingRules.
+type GetPairingRulesParams struct {
+	// UserId Только правила, где пользователь автор или ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы; владельцы по правилам /ownership назначаются в первую очередь
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Draft Создать черновик (DRAFT) без ревьюверов; они назначаются при /pullRequest/ready
+	Draft *bool `json:"draft,omitempty"`
+
+	// Labels Метки PR; хотя бы один ревьюер подбирается по совпадению навыков
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
 }
 
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	// Status Фильтр по статусу PR
+	Status *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// AuthorId Только PR этого автора
+	AuthorId *string `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// ReviewerId Только PR, где пользователь назначен ревьювером
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Только PR авторов, состоящих в команде
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom Созданные не раньше (RFC3339, включительно)
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Созданные раньше (RFC3339, не включительно)
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Cursor Курсор следующей страницы из next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы (по умолчанию 50, не больше 500)
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestPreviewAssignmentJSONBody defines parameters for PostPullRequestPreviewAssignment.
+type PostPullRequestPreviewAssignmentJSONBody struct {
+	AuthorId        string    `json:"author_id"`
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	// ChangedFiles Изменённые файлы; владельцы по правилам /ownership назначаются в первую очередь
+	ChangedFiles  *[]string `json:"changed_files,omitempty"`
+	PullRequestId string    `json:"pull_request_id"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+	// NewUserId Конкретный новый ревьювер (из любой команды); не указан — выбирается стратегией из команды старого ревьювера
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestSubmitReviewJSONBody defines parameters for PostPullRequestSubmitReview.
+type PostPullRequestSubmitReviewJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// State Решение ревьювера; pending — решение ещё не отправлено
+	State  ReviewState `json:"state"`
+	UserId string      `json:"user_id"`
 }
 
 // GetStatsReviewerUserIdParams defines parameters for GetStatsReviewerUserId.
@@ -240,26 +855,135 @@ type GetStatsSummaryParams struct {
 
 	// Limit Топ-N ревьюверов (по умолчанию 10)
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Rollup Учитывать в назначениях команды назначения всех команд ниже по иерархии
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty"`
 }
 
 // GetStatsSummaryParamsStatus defines parameters for GetStatsSummary.
 type GetStatsSummaryParamsStatus string
 
+// PostTeamAddMemberJSONBody defines parameters for PostTeamAddMember.
+type PostTeamAddMemberJSONBody struct {
+	// Force Перенести пользователя, если он состоит в другой команде
+	Force    *bool      `json:"force,omitempty"`
+	Member   TeamMember `json:"member"`
+	TeamName string     `json:"team_name"`
+}
+
 // PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
 type PostTeamDeactivateJSONBody struct {
-	TeamName string `json:"team_name"`
+	// IncludeDescendants Деактивировать также участников всех команд ниже по иерархии
+	IncludeDescendants *bool  `json:"include_descendants,omitempty"`
+	TeamName           string `json:"team_name"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// IncludeDescendants Вернуть также команды ниже по иерархии на всех уровнях
+	IncludeDescendants *bool `form:"include_descendants,omitempty" json:"include_descendants,omitempty"`
+}
+
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	// Query Подстрока имени команды (без учёта регистра)
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Cursor Курсор следующей страницы из next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы (по умолчанию 50, не больше 500)
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTeamPolicyParams defines parameters for GetTeamPolicy.
+type GetTeamPolicyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamSetParentJSONBody defines parameters for PostTeamSetParent.
+type PostTeamSetParentJSONBody struct {
+	// ParentTeamName Новая родительская команда; без значения команда становится командой верхнего уровня
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	EndsAt time.Time `json:"ends_at"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя, когда период начнётся
+	ReassignReviews *bool     `json:"reassign_reviews,omitempty"`
+	Reason          *string   `json:"reason,omitempty"`
+	StartsAt        time.Time `json:"starts_at"`
+	UserId          string    `json:"user_id"`
+}
+
+// PostUsersDeleteUnavailabilityJSONBody defines parameters for PostUsersDeleteUnavailability.
+type PostUsersDeleteUnavailabilityJSONBody struct {
+	Id int64 `json:"id"`
 }
 
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Label Вернуть только PR с этой меткой
+	Label *string `form:"label,omitempty" json:"label,omitempty"`
+
+	// State Вернуть только PR, ревью пользователя в которых находится в этом состоянии
+	State *ReviewState `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetUsersGetUnavailabilityParams defines parameters for GetUsersGetUnavailability.
+type GetUsersGetUnavailabilityParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersListParams defines parameters for GetUsersList.
+type GetUsersListParams struct {
+	// TeamName Только участники команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// IsActive Фильтр по активности
+	IsActive *bool `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// Query Подстрока user_id или имени пользователя (без учёта регистра)
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Cursor Курсор следующей страницы из next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы (по умолчанию 50, не больше 500)
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostUsersSetCapacityJSONBody defines parameters for PostUsersSetCapacity.
+type PostUsersSetCapacityJSONBody struct {
+	// MaxOpenReviews Максимум одновременных ревью открытых PR; не указан — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReviewWeight Относительный вес (0, 100]; не указан — 1
+	ReviewWeight *float64 `json:"review_weight,omitempty"`
+	UserId       string   `json:"user_id"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -268,35 +992,220 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetRoleJSONBody defines parameters for PostUsersSetRole.
+type PostUsersSetRoleJSONBody struct {
+	// Role Сеньорность пользователя; lead считается senior-ревьювером. Без значения при создании команды — junior (у существующего пользователя роль не меняется)
+	Role   Role   `json:"role"`
+	UserId string `json:"user_id"`
+}
+
+// PostUsersSetScheduleJSONBody defines parameters for PostUsersSetSchedule.
+type PostUsersSetScheduleJSONBody struct {
+	Schedule WorkSchedule `json:"schedule"`
+	UserId   string       `json:"user_id"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostWebhooksAddJSONBody defines parameters for PostWebhooksAdd.
+type PostWebhooksAddJSONBody struct {
+	Events []WebhookEvent `json:"events"`
+
+	// Secret Ключ подписи; в ответах не возвращается
+	Secret string `json:"secret"`
+
+	// Url Абсолютный http(s) URL
+	Url string `json:"url"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	// SubscriptionId Только доставки этой подписки
+	SubscriptionId *int64 `form:"subscription_id,omitempty" json:"subscription_id,omitempty"`
+
+	// Status Фильтр по статусу доставки
+	Status *GetWebhooksDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество записей (по умолчанию 50, не больше 500)
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetWebhooksDeliveriesParamsStatus defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParamsStatus string
+
+// PostIntegrationsGithubWebhookJSONRequestBody defines body for PostIntegrationsGithubWebhook for application/json ContentType.
+type PostIntegrationsGithubWebhookJSONRequestBody = PostIntegrationsGithubWebhookJSONBody
+
+// PostIntegrationsGitlabWebhookJSONRequestBody defines body for PostIntegrationsGitlabWebhook for application/json ContentType.
+type PostIntegrationsGitlabWebhookJSONRequestBody = PostIntegrationsGitlabWebhookJSONBody
+
+// PostOwnershipImportJSONRequestBody defines body for PostOwnershipImport for application/json ContentType.
+type PostOwnershipImportJSONRequestBody PostOwnershipImportJSONBody
+
+// PostOwnershipRulesJSONRequestBody defines body for PostOwnershipRules for application/json ContentType.
+type PostOwnershipRulesJSONRequestBody PostOwnershipRulesJSONBody
+
+// PostPairingAddJSONRequestBody defines body for PostPairingAdd for application/json ContentType.
+type PostPairingAddJSONRequestBody PostPairingAddJSONBody
+
+// PostPairingDeleteJSONRequestBody defines body for PostPairingDelete for application/json ContentType.
+type PostPairingDeleteJSONRequestBody PostPairingDeleteJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestPreviewAssignmentJSONRequestBody defines body for PostPullRequestPreviewAssignment for application/json ContentType.
+type PostPullRequestPreviewAssignmentJSONRequestBody PostPullRequestPreviewAssignmentJSONBody
+
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestSubmitReviewJSONRequestBody defines body for PostPullRequestSubmitReview for application/json ContentType.
+type PostPullRequestSubmitReviewJSONRequestBody PostPullRequestSubmitReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamAddMemberJSONRequestBody defines body for PostTeamAddMember for application/json ContentType.
+type PostTeamAddMemberJSONRequestBody PostTeamAddMemberJSONBody
+
 // PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
 type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
 
+// PostTeamPolicyJSONRequestBody defines body for PostTeamPolicy for application/json ContentType.
+type PostTeamPolicyJSONRequestBody = TeamPolicy
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody PostTeamSetParentJSONBody
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersDeleteUnavailabilityJSONRequestBody defines body for PostUsersDeleteUnavailability for application/json ContentType.
+type PostUsersDeleteUnavailabilityJSONRequestBody PostUsersDeleteUnavailabilityJSONBody
+
+// PostUsersSetCapacityJSONRequestBody defines body for PostUsersSetCapacity for application/json ContentType.
+type PostUsersSetCapacityJSONRequestBody PostUsersSetCapacityJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetRoleJSONRequestBody defines body for PostUsersSetRole for application/json ContentType.
+type PostUsersSetRoleJSONRequestBody PostUsersSetRoleJSONBody
+
+// PostUsersSetScheduleJSONRequestBody defines body for PostUsersSetSchedule for application/json ContentType.
+type PostUsersSetScheduleJSONRequestBody PostUsersSetScheduleJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
+// PostWebhooksAddJSONRequestBody defines body for PostWebhooksAdd for application/json ContentType.
+type PostWebhooksAddJSONRequestBody PostWebhooksAddJSONBody
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Поток доменных событий (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(c *fiber.Ctx, params GetEventsStreamParams) error
+	// Принять webhook pull_request от GitHub
+	// (POST /integrations/github/webhook)
+	PostIntegrationsGithubWebhook(c *fiber.Ctx) error
+	// Принять webhook Merge Request Hook от GitLab
+	// (POST /integrations/gitlab/webhook)
+	PostIntegrationsGitlabWebhook(c *fiber.Ctx) error
+	// Состояние отправки ревьюверов на хостинг кода, недавно изменённые сначала
+	// (GET /integrations/syncStatus)
+	GetIntegrationsSyncStatus(c *fiber.Ctx, params GetIntegrationsSyncStatusParams) error
+	// Заменить правила владения содержимым файла CODEOWNERS
+	// (POST /ownership/import)
+	PostOwnershipImport(c *fiber.Ctx) error
+	// Получить правила владения кодом (в порядке применения, побеждает последнее совпавшее)
+	// (GET /ownership/rules)
+	GetOwnershipRules(c *fiber.Ctx) error
+	// Заменить правила владения кодом
+	// (POST /ownership/rules)
+	PostOwnershipRules(c *fiber.Ctx) error
+	// Добавить правило пары (запрет или обязательное назначение ревьювера)
+	// (POST /pairing/add)
+	PostPairingAdd(c *fiber.Ctx) error
+	// Удалить правило пары
+	// (POST /pairing/delete)
+	PostPairingDelete(c *fiber.Ctx) error
+	// Получить правила пар автор/ревьювер
+	// (GET /pairing/rules)
+	GetPairingRules(c *fiber.Ctx, params GetPairingRulesParams) error
+	// Добавить ревьювера к открытому PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(c *fiber.Ctx) error
+	// Закрыть PR без merge (CLOSED)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(c *fiber.Ctx) error
+	// Создать PR и автоматически назначить ревьюверов по политике команды автора (по умолчанию до 2)
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(c *fiber.Ctx) error
+	// Получить PR с ревьюверами, метками и состоянием ревью
+	// (GET /pullRequest/get)
+	GetPullRequestGet(c *fiber.Ctx, params GetPullRequestGetParams) error
+	// Список PR с фильтрами, новые сначала
+	// (GET /pullRequest/list)
+	GetPullRequestList(c *fiber.Ctx, params GetPullRequestListParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(c *fiber.Ctx) error
-	// Переназначить конкретного ревьювера на другого из его команды
+	// Показать, кого назначил бы /pullRequest/create, ничего не сохраняя
+	// (POST /pullRequest/previewAssignment)
+	PostPullRequestPreviewAssignment(c *fiber.Ctx) error
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(c *fiber.Ctx) error
+	// Переназначить конкретного ревьювера на другого из его команды или на указанного пользователя
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(c *fiber.Ctx) error
+	// Снять ревьювера с открытого PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(c *fiber.Ctx) error
+	// Переоткрыть закрытый PR
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(c *fiber.Ctx) error
+	// Отправить решение ревьювера по PR
+	// (POST /pullRequest/submitReview)
+	PostPullRequestSubmitReview(c *fiber.Ctx) error
 	// Базовая агрегация по ревьюверу, PR, статусу и команде
 	// (GET /stats)
 	GetStats(c *fiber.Ctx) error
@@ -312,18 +1221,75 @@ type ServerInterface interface {
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(c *fiber.Ctx) error
+	// Добавить пользователя в команду или перенести его из другой команды
+	// (POST /team/addMember)
+	PostTeamAddMember(c *fiber.Ctx) error
 	// Деактивировать всех участников команды и переназначить/удалить ревьюеров
 	// (POST /team/deactivate)
 	PostTeamDeactivate(c *fiber.Ctx) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(c *fiber.Ctx, params GetTeamGetParams) error
+	// Список команд по имени с постраничной выдачей
+	// (GET /team/list)
+	GetTeamList(c *fiber.Ctx, params GetTeamListParams) error
+	// Получить политику назначения ревьюеров команды
+	// (GET /team/policy)
+	GetTeamPolicy(c *fiber.Ctx, params GetTeamPolicyParams) error
+	// Задать политику назначения ревьюеров команды
+	// (POST /team/policy)
+	PostTeamPolicy(c *fiber.Ctx) error
+	// Исключить пользователя из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(c *fiber.Ctx) error
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(c *fiber.Ctx) error
+	// Перенести команду вместе с её подкомандами под другую команду или на верхний уровень
+	// (POST /team/setParent)
+	PostTeamSetParent(c *fiber.Ctx) error
+	// Добавить период недоступности пользователя (отпуск, больничный)
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(c *fiber.Ctx) error
+	// Удалить период недоступности
+	// (POST /users/deleteUnavailability)
+	PostUsersDeleteUnavailability(c *fiber.Ctx) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(c *fiber.Ctx, params GetUsersGetReviewParams) error
+	// Получить текущие и будущие периоды недоступности пользователя
+	// (GET /users/getUnavailability)
+	GetUsersGetUnavailability(c *fiber.Ctx, params GetUsersGetUnavailabilityParams) error
+	// Список пользователей с фильтрами и поиском
+	// (GET /users/list)
+	GetUsersList(c *fiber.Ctx, params GetUsersListParams) error
+	// Задать лимит одновременных ревью и вес пользователя при выборе ревьюверов
+	// (POST /users/setCapacity)
+	PostUsersSetCapacity(c *fiber.Ctx) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(c *fiber.Ctx) error
+	// Задать сеньорность пользователя
+	// (POST /users/setRole)
+	PostUsersSetRole(c *fiber.Ctx) error
+	// Задать часовой пояс и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(c *fiber.Ctx) error
+	// Заменить навыки пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(c *fiber.Ctx) error
+	// Подписать URL на доменные события
+	// (POST /webhooks/add)
+	PostWebhooksAdd(c *fiber.Ctx) error
+	// Удалить подписку вместе с журналом её доставок
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(c *fiber.Ctx) error
+	// Журнал доставок, новые сначала
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(c *fiber.Ctx, params GetWebhooksDeliveriesParams) error
+	// Получить подписки на события
+	// (GET /webhooks/subscriptions)
+	GetWebhooksSubscriptions(c *fiber.Ctx) error
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -333,22 +1299,311 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc fiber.Handler
 
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", query, &params.UserId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", query, &params.TeamName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter team_name: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", query, &params.LastEventId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter last_event_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetEventsStream(c, params)
+}
+
+// PostIntegrationsGithubWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsGithubWebhook(c *fiber.Ctx) error {
+
+	return siw.Handler.PostIntegrationsGithubWebhook(c)
+}
+
+// PostIntegrationsGitlabWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsGitlabWebhook(c *fiber.Ctx) error {
+
+	return siw.Handler.PostIntegrationsGitlabWebhook(c)
+}
+
+// GetIntegrationsSyncStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetIntegrationsSyncStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntegrationsSyncStatusParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "pr_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pr_id", query, &params.PrId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter pr_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetIntegrationsSyncStatus(c, params)
+}
+
+// PostOwnershipImport operation middleware
+func (siw *ServerInterfaceWrapper) PostOwnershipImport(c *fiber.Ctx) error {
+
+	return siw.Handler.PostOwnershipImport(c)
+}
+
+// GetOwnershipRules operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnershipRules(c *fiber.Ctx) error {
+
+	return siw.Handler.GetOwnershipRules(c)
+}
+
+// PostOwnershipRules operation middleware
+func (siw *ServerInterfaceWrapper) PostOwnershipRules(c *fiber.Ctx) error {
+
+	return siw.Handler.PostOwnershipRules(c)
+}
+
+// PostPairingAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostPairingAdd(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPairingAdd(c)
+}
+
+// PostPairingDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostPairingDelete(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPairingDelete(c)
+}
+
+// GetPairingRules operation middleware
+func (siw *ServerInterfaceWrapper) GetPairingRules(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPairingRulesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", query, &params.UserId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetPairingRules(c, params)
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestAddReviewer(c)
+}
+
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestClose(c)
+}
+
 // PostPullRequestCreate operation middleware
 func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *fiber.Ctx) error {
 
-	return siw.Handler.PostPullRequestCreate(c)
+	return siw.Handler.PostPullRequestCreate(c)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument pull_request_id is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", query, &params.PullRequestId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetPullRequestGet(c, params)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", query, &params.AuthorId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter author_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", query, &params.ReviewerId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter reviewer_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", query, &params.TeamName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter team_name: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", query, &params.CreatedFrom)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter created_from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", query, &params.CreatedTo)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter created_to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetPullRequestList(c, params)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestMerge(c)
+}
+
+// PostPullRequestPreviewAssignment operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPreviewAssignment(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestPreviewAssignment(c)
+}
+
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestReady(c)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestReassign(c)
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(c *fiber.Ctx) error {
+
+	return siw.Handler.PostPullRequestRemoveReviewer(c)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *fiber.Ctx) error {
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(c *fiber.Ctx) error {
 
-	return siw.Handler.PostPullRequestMerge(c)
+	return siw.Handler.PostPullRequestReopen(c)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *fiber.Ctx) error {
+// PostPullRequestSubmitReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSubmitReview(c *fiber.Ctx) error {
 
-	return siw.Handler.PostPullRequestReassign(c)
+	return siw.Handler.PostPullRequestSubmitReview(c)
 }
 
 // GetStats operation middleware
@@ -447,6 +1702,13 @@ func (siw *ServerInterfaceWrapper) GetStatsSummary(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "rollup" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rollup", query, &params.Rollup)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rollup: %w", err).Error())
+	}
+
 	return siw.Handler.GetStatsSummary(c, params)
 }
 
@@ -456,6 +1718,12 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(c *fiber.Ctx) error {
 	return siw.Handler.PostTeamAdd(c)
 }
 
+// PostTeamAddMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMember(c *fiber.Ctx) error {
+
+	return siw.Handler.PostTeamAddMember(c)
+}
+
 // PostTeamDeactivate operation middleware
 func (siw *ServerInterfaceWrapper) PostTeamDeactivate(c *fiber.Ctx) error {
 
@@ -491,9 +1759,122 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter team_name: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "include_descendants" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_descendants", query, &params.IncludeDescendants)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_descendants: %w", err).Error())
+	}
+
 	return siw.Handler.GetTeamGet(c, params)
 }
 
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamListParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", query, &params.Query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter query: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetTeamList(c, params)
+}
+
+// GetTeamPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamPolicy(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamPolicyParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument team_name is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", query, &params.TeamName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter team_name: %w", err).Error())
+	}
+
+	return siw.Handler.GetTeamPolicy(c, params)
+}
+
+// PostTeamPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamPolicy(c *fiber.Ctx) error {
+
+	return siw.Handler.PostTeamPolicy(c)
+}
+
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(c *fiber.Ctx) error {
+
+	return siw.Handler.PostTeamRemoveMember(c)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(c *fiber.Ctx) error {
+
+	return siw.Handler.PostTeamRename(c)
+}
+
+// PostTeamSetParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetParent(c *fiber.Ctx) error {
+
+	return siw.Handler.PostTeamSetParent(c)
+}
+
+// PostUsersAddUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddUnavailability(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersAddUnavailability(c)
+}
+
+// PostUsersDeleteUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDeleteUnavailability(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersDeleteUnavailability(c)
+}
+
 // GetUsersGetReview operation middleware
 func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *fiber.Ctx) error {
 
@@ -523,15 +1904,193 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", query, &params.Label)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter label: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", query, &params.State)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter state: %w", err).Error())
+	}
+
 	return siw.Handler.GetUsersGetReview(c, params)
 }
 
+// GetUsersGetUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetUnavailability(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetUnavailabilityParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument user_id is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", query, &params.UserId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetUsersGetUnavailability(c, params)
+}
+
+// GetUsersList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersList(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersListParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", query, &params.TeamName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter team_name: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", query, &params.IsActive)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter is_active: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", query, &params.Query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter query: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetUsersList(c, params)
+}
+
+// PostUsersSetCapacity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetCapacity(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersSetCapacity(c)
+}
+
 // PostUsersSetIsActive operation middleware
 func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *fiber.Ctx) error {
 
 	return siw.Handler.PostUsersSetIsActive(c)
 }
 
+// PostUsersSetRole operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetRole(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersSetRole(c)
+}
+
+// PostUsersSetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSchedule(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersSetSchedule(c)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(c *fiber.Ctx) error {
+
+	return siw.Handler.PostUsersSetSkills(c)
+}
+
+// PostWebhooksAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksAdd(c *fiber.Ctx) error {
+
+	return siw.Handler.PostWebhooksAdd(c)
+}
+
+// PostWebhooksDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDelete(c *fiber.Ctx) error {
+
+	return siw.Handler.PostWebhooksDelete(c)
+}
+
+// GetWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveries(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "subscription_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subscription_id", query, &params.SubscriptionId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter subscription_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetWebhooksDeliveries(c, params)
+}
+
+// GetWebhooksSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksSubscriptions(c *fiber.Ctx) error {
+
+	return siw.Handler.GetWebhooksSubscriptions(c)
+}
+
 // FiberServerOptions provides options for the Fiber server.
 type FiberServerOptions struct {
 	BaseURL     string
@@ -553,12 +2112,50 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 		router.Use(fiber.Handler(m))
 	}
 
+	router.Get(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
+
+	router.Post(options.BaseURL+"/integrations/github/webhook", wrapper.PostIntegrationsGithubWebhook)
+
+	router.Post(options.BaseURL+"/integrations/gitlab/webhook", wrapper.PostIntegrationsGitlabWebhook)
+
+	router.Get(options.BaseURL+"/integrations/syncStatus", wrapper.GetIntegrationsSyncStatus)
+
+	router.Post(options.BaseURL+"/ownership/import", wrapper.PostOwnershipImport)
+
+	router.Get(options.BaseURL+"/ownership/rules", wrapper.GetOwnershipRules)
+
+	router.Post(options.BaseURL+"/ownership/rules", wrapper.PostOwnershipRules)
+
+	router.Post(options.BaseURL+"/pairing/add", wrapper.PostPairingAdd)
+
+	router.Post(options.BaseURL+"/pairing/delete", wrapper.PostPairingDelete)
+
+	router.Get(options.BaseURL+"/pairing/rules", wrapper.GetPairingRules)
+
+	router.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+
+	router.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+
 	router.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 
+	router.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+
+	router.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+
 	router.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 
+	router.Post(options.BaseURL+"/pullRequest/previewAssignment", wrapper.PostPullRequestPreviewAssignment)
+
+	router.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+
 	router.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 
+	router.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+
+	router.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+
+	router.Post(options.BaseURL+"/pullRequest/submitReview", wrapper.PostPullRequestSubmitReview)
+
 	router.Get(options.BaseURL+"/stats", wrapper.GetStats)
 
 	router.Get(options.BaseURL+"/stats/pr/:pr_id", wrapper.GetStatsPrPrId)
@@ -569,69 +2166,296 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 
+	router.Post(options.BaseURL+"/team/addMember", wrapper.PostTeamAddMember)
+
 	router.Post(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
 
 	router.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 
+	router.Get(options.BaseURL+"/team/list", wrapper.GetTeamList)
+
+	router.Get(options.BaseURL+"/team/policy", wrapper.GetTeamPolicy)
+
+	router.Post(options.BaseURL+"/team/policy", wrapper.PostTeamPolicy)
+
+	router.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+
+	router.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+
+	router.Post(options.BaseURL+"/team/setParent", wrapper.PostTeamSetParent)
+
+	router.Post(options.BaseURL+"/users/addUnavailability", wrapper.PostUsersAddUnavailability)
+
+	router.Post(options.BaseURL+"/users/deleteUnavailability", wrapper.PostUsersDeleteUnavailability)
+
 	router.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 
+	router.Get(options.BaseURL+"/users/getUnavailability", wrapper.GetUsersGetUnavailability)
+
+	router.Get(options.BaseURL+"/users/list", wrapper.GetUsersList)
+
+	router.Post(options.BaseURL+"/users/setCapacity", wrapper.PostUsersSetCapacity)
+
 	router.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 
+	router.Post(options.BaseURL+"/users/setRole", wrapper.PostUsersSetRole)
+
+	router.Post(options.BaseURL+"/users/setSchedule", wrapper.PostUsersSetSchedule)
+
+	router.Post(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+
+	router.Post(options.BaseURL+"/webhooks/add", wrapper.PostWebhooksAdd)
+
+	router.Post(options.BaseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+
+	router.Get(options.BaseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+
+	router.Get(options.BaseURL+"/webhooks/subscriptions", wrapper.GetWebhooksSubscriptions)
+
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xbbW8bx/H/Kov9/4HYAC1SlJ0ieqfYiqsXVlhKAYoKBHHiraRLyLvL3VGJIBCwxCRu",
-	"KyOqgb4oDMSum34AmhYrWhKprzD7jYrdvefbOx5FSXb6xqDv9mFu9jczv50Z7eOG0TINneiOjRf3salY",
-	"Sos4xOL/WydKa1VpkT+0ibXHHqjEblia6WiGjhcx/AojGMIZ9OCcPocRjGGAYAgX9BjBGYzhAnowghN6",
-	"hAtYYzO+5QsVsK60CF7EDlFadf67gC3ybVuziIoXHatNCthu7JCWwjZ19kw22HYsTd/GnU4Bf2UTa0VN",
-	"k+ofcAIDGNFDGNIfhHz0EMb0KYJLGHNRT2EMff54AOf0OEW8tk2suqZOJVzHe8kV+IgoDUfbVRxSJXa7",
-	"6XAVW4ZJLEcjtpDdG6HW2X52aFFNd8g2sXCHCaDYtratMxHk71vGrvxlp+A9Mja/Jg2HDV+2LMOqEts0",
-	"dJuwSeR7pWU2xU/2jv1oGCqbtfrlev2LL79afYQLuEVsW9lmTy1iG22rQZBuOGjLaOsq3yn6cf5S0cdi",
-	"4X1M9HYLL27g9eWlJ/XlP66sra/hAq5UI7+fLFcfL7O9mRxLa2srj1fd/9YfLq0+Wnm0tL7svhVS1grx",
-	"QwnJLUNTcLgbQrRgfC2hu9h48YU1iYor1TVHkRy4OMd6Q+fvtgyrxUax4/r0Pi5IjtbkGJRKnrKpLdm1",
-	"7ewYKQsVcMMiHIBKVCZVccg9R+PmqbebTWWTIURYgETF1vaMS5ipApqWcBOyd55ptDwfpjmkxX/8v0W2",
-	"8CL+v2Lg44qucRaroVnLu0Tn6nPXVixL2RNL72rkO9co/WUTEsSn2Y7itO0wwr+sLK/iAnaxLEOoYym6",
-	"vUWs3MCQHn+72aySb9vETgUeUeuRr4r6TtfjIRhBD07Zv/QZ86Uwokf0R0SfwgD69Dn9GfowoE+ZF0V3",
-	"SnNz5bvMheZWUC40Ls0MxllWMNvNZt0SukxFZXhMKj6nR0PMx8RFkW0c1qm/ZUF25rVs3KztGJYzrf/4",
-	"X1CWTC9JJ5GMZTuKPsHvJT5SJ9/5B+KqayIejaYan5MjHlTdGWlRYdpY5Dp505pijmESfboZFmkQ3amb",
-	"Vn53nkCwxOt4dC6f6lJUtrlXN638YgkSIBFmc68eYD3XWmt8eMZ6jE3nXo1R+4y1mK5yr8XouHytVMWu",
-	"tVstRbD3qH5Ny9VLvWG0pwno2erhN42r8IQsPTmGWZdThOvVlvtZMl1xJeU0qyv51oRA6y7KoqK0SGtz",
-	"GiWwVZ7wOamnlRIjYv4+fIX0hKiliO1umBBes+v8EhbebtMwmkTRs/2GeJdP0OAy6c8phHZOk/maLhET",
-	"NJrY+iv7CorK2uRG1RgGQbZKfeObXaXThBP2SNO3DD5Yc1icx5Uq8sIzWvI9E1oj1q7WIOjOOrEdtK7Y",
-	"3xTQF0qzicql8gNGs3eJZQu2Pj9Xmit5IVYxNbyIF+ZKcwuM9CjODv+sohlExqJg1vzbDXFFYBpQGPlf",
-	"UZlIhu2EIulDMVwcALGdzw11T9zfdcclQ4ppNrUGX6H4tc2kiuQSQrQRt+exhCli07o3XyrNS4naIl5S",
-	"VWQTxWrs4E447fIh2OmMTLMmRUU0s8QfiKwM/7ByaX46hQtuIrvqbeB2mVnNAhPkOs8lCCwinnQyDkqI",
-	"l5PIpRlS9MpaqSJ6AGM4hRPowYgd5v3S/RxaC2TMkieaKZPsD3+DvkgvFsM5T+ixS/RA3KTfuxnJIyHd",
-	"Z9OdaTwhF06QBQm5ShVpKlKaFlHUPUS+12zHjp3FTN/J9NyF/8AA0QPapX+BAT2gh9CnXRjQQ7GTR+ow",
-	"vPFOhB7S56hSRTBE0BOaYiriydlnbA04Y6/C+YYhnwMnMEZlecoBhnAayzD7q9On0GP+WtnmoA/hycY1",
-	"JmXEI/ILTW6H+ISPnsEfpptZltFM9F8TPNPVPE/pdjxPkKrBLMLdmy/dK99fny8vLtxffPDpn67NN7kk",
-	"9/a9E/S5g+LWMqbHvGQyRJ44t+ytmDxxtxS33dfcrgbcRD3rPYMenLlCozsw5DMv4BLGvIYyoofceI8R",
-	"jOGS22mP/gRDenw3vy16idzc5uilZ2axyEReRYD1SobK1spiuTMbciGyxYc3a8Y02w9unFCwbzCbSoOo",
-	"9U2G0PYDfH1WHFs8IyM+hj6M4R2Mk0GphyfmJS0c3amWw3vAa776IJGOHzIT7tMjUdBkFs3k+yDeZAjn",
-	"LIDLK6vPZd5mSgrk5hdYlOCiB47qF7EHnDK/c8Hd0LHgDswvHcA5DJBfPtxVmu00OuUPCuhUQ9F1w0Ge",
-	"T0KGjoQMqFIVqtCNh4quaqp7o4rKRQ85gWFOn3bh0q2fcDfKyOFQUCOmqyzRYjXOQDrdQOKSi1xI8atj",
-	"w5MHaTri2UBXUGcpVDyOefr0Q3tLj+A8UQqSMbKL7I+I1G3DJWT39qvZvIrsORnkGMjZ0WxX09dHYeEX",
-	"6NGntEv/HBjRiQh2fokLLpk5Q5/hGrmhTGJ/9DgZNZNDXSbLiOoIzthrHifTnAjXNYITJiMbwocJrjsQ",
-	"v+NNFRmR1fby19tEEkkfE0ckuGeND24+fCOaQCn7xdwgbtYiSe+NcPpyIeHya6GMdmzt30USTXhTaXxD",
-	"dNWbIhLXsSkPQtkaFt87tdygElqSgekNDwcnLu/h9E5ca9gv3nUSh8gLDg1hasfswvKOY+Cdx5W430rg",
-	"gnYLqFIt+DvQLruA8QtV+L45CMFBCB3CQdG0ivv8RDoTIVGxKtYKT2eEeoE29kWHjKk4O0GDjDl1e0zt",
-	"SnjLd1Re84P0sJKnw93LR0i/ZaJyYEj8yAXtss/IOnnPyxb3XROYjAAvLSnarHIh4SqtUoVE59ZLzmbc",
-	"xAA9ZAYWiuXc1ob0R/HFsoatptbSHBzeM5zHXSjL2ihuEpHR8mteXIYcgOCWt57Sep2fzEmCIE/Hjfym",
-	"wDTvmPzOLBjbQcEwE7teYTEBWgkVeMZkFCGWXWCZEH3+qIfuVL94uLCw8NndFKRtWUZLDrSMyn8K4kcw",
-	"oD9dRQjHuA4R/s2oDn1OD90GyXigSbU2v8skECF/YTEhxr9gDJf3VlN6jIRgXbjgyHzGY96Q/ozmS3d/",
-	"I74ggk6Z0YXOQZgd46S5ucUresibXfOvgOgB4lcQdnW7kOo9xSIZ/SoqqpqdqFknSmtJVWdJzvhV5Y1I",
-	"7VEElhCjmw+XAxfxUlNrEMwwljWpHJ30ubHJOWSYW5rKnugWyE0X1/1b1zUXdrzmjg+tEp9uZ2RgPFlz",
-	"KCpPEuRlpKoSLvZ4sbE0W0El2n8cXFD9777Bskr8665aYolcDbvctrvMT/IVvC79CxiiO4EC6Qt6WIQx",
-	"vHWTW+f0WGQupMkcGMD7cDaXnWDEIwSt7JMdQ9AYP4t/kAIzG5dTt5XcSpZV8lcAC9Gm/3Kox38+N/wS",
-	"f38gQ+A/YQCntCtCBzttxMldD854qOiL6ykMb5+Hvsyupyaj4N8jcg+9OCgspE8PYEB/TBoGLynGqolD",
-	"efKHZ3SKtMv9z7mb4IkR2WjgTJiJS1/TWCwb/5g4SQIrU2UwpBj9W50rUpmPKPZK7Tlf6I2h6BW8pX+F",
-	"AYNFPIP28SNaXMW60WRiDj+fgkDuXBgExdU0C4js9m8/9kdOi8fw32jNjsZwyUhsf6MVp1o8YZivOn+L",
-	"/crynrioMLlKTG/gkt0KYAxnqFL9RDjDtL+TmwDOSvUTelRA8I6hObMmlKuk4AGYIzECYJs4K/aS34qY",
-	"zjX41LXQ6BnYRsihbSlNm+THyJUbTFMPOqvL8Qb4id8OnlCBzGVPdPUZqvJ2mtRBnfPu8CpEbl+IIg+8",
-	"T0XmbyjT9qu40rsf5/KQH+AcevAOhTjQyO08GWb98WvC0Dr+s30vnyKiCAvs7gMxOPQgUoQKPf89UZrO",
-	"Du7UOv8NAAD//x+IdMduPAAA",
+	"H4sIAAAAAAAC/+y9fXPcVnYn/FXwIKkKlQJfJTk1VE1VaIljMSORnG5qPBlTTw/YDZEdNdE9AFqy4lWV",
+	"SEbj8UqxopQrM5Vk7Hi8Vdl/tqpFsa0mKba+wsVX2E+ydc65F7gXuECj2SRF2aranVhN4OK+nHtef+ec",
+	"z8xqc7PVdB038M3Zz8yW7dmbTuB4+K+rbc9ver9oO94D+GfN8atevRXUm645a7J/D3fCR+EW64ePjHCL",
+	"HbIu2wt3wi/DL1iX7RvhVrgdPmIddsR64e/CJwbrsVeG63waVKo4rsHehI/wpSf4Irz2kvUN1g+32S7r",
+	"htusY1pmHT72W5yDZbr2pmPOmjSAaZl+dcPZtGFywYMW/MUPvLq7bj58aJk36pv1IGvy/8U67BV7zbrh",
+	"o/RMx9gb1jfCHfaa9dlh+Dn/05fG5SnLYEesa7AX+Jen4e9Z17g8NXUhY6INmIMyzztNb9MOzFmz7gYX",
+	"Z0xLTLzuBs664+HMVxx7c9HedLIm/x1Mhx2wDkyBHbE+zKjHXofPDHbA+uw1TngvfJIxq8CxNyv435bp",
+	"Ob9t1z2nZs4GXtvJ39FbvuMt1LJm9Ue2x7rsKNxmvfCfaH7hNlIHbCdO9RXrs138ucsOw2cZ02v7jlep",
+	"14aa3EPxRyTcOd+vr7ubjhtctd1avWYHDlK312w5XlB38CEbH6pU3UCzmG/ZAZ/zAZACUMerrHU8BZoA",
+	"cjpiHSSWw3ArfGYgce+GT8MvgcrgWAz2inUMthtuhc/h/8ADr3EbkkRgmdVmzak077uOp5ndv7JD1sHt",
+	"PmTd8Hd4tYCY2VH4nB2xo/BJ+NgI/4l12D47hLla+GkkGyCObrhtwPNA+XDj3uAMd8Mn7HU8mbVms+HY",
+	"Lkym2XLciufcqzv3fc10/pv1kAHwu3sQPgqfhNs4ieWSZbCXMNXs3VM3SrsbMSGkPv41exE+Y6/i8WD5",
+	"bF8edRevOV5q5Dkdtst67DDcgd86MFsreYTdcBsPEQ4L+dIe68Qzk7aGdqVy36mvbwTao+qGW6nJsA7N",
+	"pGfgrr+Aa8K6xti08X8ffcV3CufyDLkTzviAdYDNRAyk1myvNZx4Um57c43vVrOB9P6XnnPHnDX/YjJm",
+	"8pP8lkyW4Bm4NXfrjQYeaj1wNn3N3Yo+YHue/QD+LS6olknER/WJdJOly5YgJ4XUb0cfa679g1MN4Gvx",
+	"ZV6md9JXuSpuubqQvPXrWIRmqc6n1Ua75tQKjzvPX8gd9Y7daKzZ1bsV4MS+Vjx12Su6lEjO3QRjt0ia",
+	"wo/EY/GysT3WZy9IlIVPEveKpEHhM241G/Xqg0GrBUm1TE9Gd8HxNCvilJBmirtWahXyTeyxQ4O9CJ8Y",
+	"k612o1Fyftt2/GCy6jmws8XXk6BLvjhLJhzprOWV6CjyarPmXG/6QfmBW9XIlSBwNluBPC2JkzVsP6jw",
+	"Ryp2oKgEMJHxoI5y2W03Gjbcby76UgvEgRzPa+oExNfh71mPvQCegdxE6GegvLB9/Im9ASbNDoAH9dgh",
+	"63GOhJt+JBgUPLYTbsFARSaF6l2B1aVebGUwFDgKv9V0facCbCK91OsrK8vjeDn20itNqpPG3PKCET6G",
+	"p0BNYUfsZd66pGPzAztoa+i65bi1urtObPt7thc+D7fpk0LQKBsMBE+kzjqWcceuN5xazPLlEwm3ws/x",
+	"iryh22wZ/t16q8UfXy5xPRS5AFwV0mH7oAOgRviS1JYuDvyK9fhXe+EzMZtwh33PunwcSW7DTXDbm3hP",
+	"aHWmZfoP3CreDJqzaZl8OtIFic+Mnh6JvPkQw7GUXYmpgL6DFBz+njYlYi4kUvv4JCw+SRC6G4OXANSk",
+	"3+Pu7ifPuM/2h2Kv7RbsRm2IO5JkYVywcsK0Yraj2bv0xVRmkMfjPnbWNprNuyXHbzeCNLNreYNExHLM",
+	"t/MuUn3dbXqcusGoBKaPRyJRKD+T71kP9MdYR1Pp+UX4hHRRZGekFuP/70uUbbdajTrSMf+weXvQjvOZ",
+	"Z23WktDV1Q2q17Ipl+2BFWTcrbu1n8JvlhFZZsrf4Fcd04Q/wuhiUTAGPAePD1wOvmzB/HQruubY1aB+",
+	"zw6crJOvRU/UKvDhDHnnOaT6ObWsv2827+n/+FAzr3kQeCUuEnDtn9qbLVJ3I2FIksJcXFqp/Gzp1uI1",
+	"0zI3Hd+31+FXz/Gbba/qGG4zMO40224Nv6QuLhpK/VmIILHhK/NzNyvzv1oor5RNy1wuKf99c7700Tx8",
+	"G+YxVy4vfLTI/1m5Ord4beHa3Mq8aSmzXJ5bKC0sfiQNw3/45cLSjbmVhaVF0zLL84sLS6VKaf4XtxZK",
+	"OOLcjdL83LW/lz+CH698eGPp6s/x3wuLv5y7sXCtUl6ZW7kFI99anLu1cn2ptPBr/vzND+dLlaWfVZZW",
+	"rs+XKrAyPjlcJP0dvoVvVa7PlStLy/OLleVSWSsCog0fZCPgnsbPp4kx8TwdjY5m06p36gCBGpuufIR2",
+	"O9hAV5IdVKp2y67WA1AM1xrN6l3iDy7SOcyx7dr37DrJLd2aj2EX8QnplnPTAXvO36i3sq7gHa+5WYmd",
+	"ORoXXWwzgL6RsBlQjoU74e/D56BlZ5jnVzjrDXfwf7fZbrgDrNcywLIlvabPjohLozuQpOszGHNXsVtY",
+	"V8fFVA6RUmQlfwLrSsI+06tkCX8GSO8OSX6QC3vho3CHvQSRoLHHZc+Hnj2dxMzCLXYUPuNvsBdg5ZGm",
+	"Qs6bJ9o5ID8vYIgNRYJcpqiUyI8hXraeLr11ZzkyD5O+SfIEgYclfGZswrOorXYixbefoIrwyRWDHYU7",
+	"KNt3aWeE+ddFx+szrp+yw/BL8M6EX4bbJN77/PgOwh0zycM3627FXncqvlNtujV/sJMPHD0H4Q7MCacM",
+	"1vQh6BTsKP4aeLpA8Xia8HWBd2+P9WnFspum7gYfXAIGV3frm8BzpnRnDJPN0XXZf6JySt672OkLtprw",
+	"vMlWc6zv6pRjmIz9KU1memrQzDjRVIgL5k7yD1zl7oZfANGHT2k3LLoQB6R5073sDTNhgzyV5Gjtsg47",
+	"QM2Qu0Y0bjmd5oD6GXLTdkMjGdD/VNx9FGt8Ot8JKNqeq9mh/8067AXeDySpHVgHcMlwC84XHX0H4RYR",
+	"mXF16dr80seL86XyYGuAf9ES69Bd3OVSObCDQY7wFOGmSaKVzWIyPuprvoqCN8vqJw/PaCYkEt9oQ2S7",
+	"JVpeJHQzJdqmCKsVIqmS9Nb8PccNdKSlXL/i5mZsdQm951pp7mcrpmWCEif0RVAEr95YKs9f06o3gWe7",
+	"/h3HK0wpWnqw6zCe/hLm00S9VuirsV2kXj5U6cjZQuGzPrn1uX2p+P+R2Yi9RlMN/AaqHOtYBr+CNKgU",
+	"KdCMJtmeOJE4uqXda+nbg2U5ym9uz8VbqA6iZQiSaZ7BFYq5YIbh5WNTExMzF4ZylgxgFY2m79TmRrjl",
+	"nNmMMsRpefR32SvSFw1UIg8wTt5j3dTeDuneb9hrzrBhH+Kno+wS+PArHlFcJmOVn8lhsVnByG8lC+Qo",
+	"sVUGBs2/Z3vcQZqmW9lzqtgHplWUi8PETokHJ6V+Yjt1m6dyhNhbmL7dAzhEeaPpBcOy7JM+8AoswCl2",
+	"BuWAR9/O0cbr9jgt93VETW7MjoYyrwhDstmIjxO4MrpSU1FwjBGi1xrsrSNLvO0699Nvc3M1ZVtVN2x3",
+	"fSj3dYJFDuKQGPpmB4P9F4JDGnh3d7Whf1MbqVKWC/MZyL4SG1zondjjlFjuN2qwjesVsFxE0HyO3hUM",
+	"LMAxcP+AMeY37Irzaase+col5rYngZLIF3hzfnGlUpr/5cL8x5XyjTkwNXhMB5QXiMM7tUrddRr19fpa",
+	"w9GTDEcgHOqsr9cEiIJPw2PhDntDv0cxLzWSdWjAr+w1BKQku3C5xP325A3hVvWFLN+TIE5xKQBLE+6E",
+	"n4MbIfxSUrWk3UJ9KLVi/V3X3FE98CBio8NchGPxsPbaZj0Y1SI6hoOSJmspK72duUNlsbT03eZhOJ3u",
+	"AjxMDqSGj5THwZ8QPudquhx2O0yFdeKApd1qeejCsjiz8gV7xt9qTrVRdzPClyV+x7Ms12HtZdm1nN6b",
+	"/xD3IRX1tWKmpmoxGoPlSwqGaTFK4uOVtnDJJ6bwFTKOZ9LdRHaLkDFgrsSNE/4u1pPnNIagnhYah8ak",
+	"sWl/WpFhPgWRS6TL82EK7m7drdxvenfr7nplo9nWOqb+xDoQ5AW/k4gZHrJeig7JBbiP+7mFbplH6K/p",
+	"o5/tsUF/gLG029xoVu1GBe9iegp/jrT3LuvG4DsO3oywgDqk2Bh41Tm7C78Q1wJYLCEc+LTAO8nVWohf",
+	"P4OZqtuex5SSB6b1QXLvFHsN2FgDN5QIFGevtfx0kMBcti684n32MgLlRo5gLQFwP89QRCNRa8E3PKfq",
+	"uEGlNYSTMKU9Zzp0JPxggVsCZ1j5x6ar15RzmXyab3OsYErhBH3jKapZR1y2P80MbFwxGo5dM5B7IfOI",
+	"YvO+49ab3rjGE/B6wmDPRQAk4e0naCTS9CsexOmBLqFYzEgt/9CG8Y2xcMdAevqC36vdCIkuLoRu3gbO",
+	"hCN46VYCwxOzvyBJFvoQCESH/wcsWSs+yk7DqcJG3tQilti3BDfHSbykBccg0I4GIidNw7PdWnPTBIxn",
+	"261VvOZa3aW5+EGl0bQJvkb/BBo3LVNljtoJ6+Xc2oNKyytO7ORd1pD42oNKbIIVGquMj+eMJ0yIQqNB",
+	"bCxnLLgvhccCCLx+LN3lwo0ttzc3be9Ben9bHt+XSrXZHsZTnL89GBA+jgM6b5+CZqui9z2f7G7xZen2",
+	"CjepILM+GZM/NcMVTnZJJIxfddya7QbRP/UxeEiCAU72PUfDg42EIj58FD4mDsetGYgxPobYPAUdQQl7",
+	"bACOfIeEfbjNfdgQOe5dMVD0v0JB3IHgW8R/w23O+Q44G5SBcVsYNS1MG3qfIIIUhqIxAjboA2ceyNg8",
+	"SMN/CTWO0AkETU35CowxdsSX2aMsH8vAfAnC+29HEfccGzPcUQaFwyNP6+MIWyofkE6pUhYyOB4vHEdi",
+	"U29nkCDfwDTWzOdRWulzcqrCEGkB2UoE/a3YomIzMnrHkmaZtb4YXKCujwO0K9yxluFH+lMqkJOWqNyV",
+	"FEFCwsfKWVtGFBYSLieZDKQYkNYQGDkYIHJV4BvhM7YHlsOVCOzbi6D+rBc+im2/FOBfhujsAplvkbqO",
+	"//saA9BgQxsCBUuaEU8POuB3qQf3CZbLdq/QF/KcdKTuwxsAhNZuPK0j3EKehWt5g3daGmeoUAaq/5Vi",
+	"+QoydkXCNnCdTqeqCbiCHpeghhMS8UG+0H8Ot8lTmThiBV6CaNfwKWGRt8NnlPRAvI4dcTVa+NRQ88xO",
+	"RnIkWTkorS11POkwqC70mbu0THzJtFZUC225ssnV5VzVR9Gtj89iEztlaXlLFn86IRjFgKnrP52lTHJ4",
+	"DhcdMQHozOWBj5yYHD6/wjU6eO3G6U7+lkB/1hv1QHMCjlvzh/IDF8YzxDGETKhJjtvmGw6GVNKqdAi2",
+	"ouhKCTlBQMseZd/Q0EfhcxJHWg7lB7YXDLdNhZ3WkaIRea75p6zobDQbpj1q/xjq1TvsQRuUxPo1eSph",
+	"5oksW7y1W9pkVj36Y0CGq2VMTVyWH0HMJTx4Grmv1Q2n1h78/MdN725ZPKvkzGpUT9iCA5HspfM5jeFW",
+	"PiIoJ+uJv0dAZeEXssgB9ob1ow06DJ/Fyt4WeZG3hRVoLJeGg9XkMdBTtQBkdpxvDUQOhNGl7XBuUZ72",
+	"dM1p1O85Wnmbm+VZAL6Y2tQafWvEEJ8jEAS5BE2ri1CG+FKlsDwq/OApJbueXhrqiSabcrvmDQ/r9fKT",
+	"aI+dbMqLN5Airkk05fmKSoLpcLmn2gBrRK5xUqg2FbS9Fi2hKIXp5HpyHIlo+X9mJEOmMx+ly3k7++pn",
+	"QXH+zHrsDVlvr1XMmJS1GD6T98yb4J+UDI8JJduC/6bkYLS8CYps0X9TaJRzzwkp+0677XwRZWnXNOls",
+	"x+BSuNXF/X1JTpOUQYVZSdtrFNUAvYYZzXPwacuyPbVDSqQtVfUkjrruRzFXY2FucY6XE5Hcj8Z8Gwae",
+	"vNn0q837uo2FME2lZj9Q91bQ0GbThZfacCb3iQw22nDzvDrQPdK133b1uO3EnuOXHLeW4aw+wpIyUuib",
+	"s7Y9MMS4Z0oOX78GW05WYHvG2PXrszdvXrhi8FoUBEmK6+OwjsBhw/hCC+SvA4+MMijM/3/sk6np259M",
+	"jf/k9v+Y+WRq/OLtC7OfTI1fpp/+MnMnUfvPgALQHPonssbRJpu0UyNyU1YhHZlMJ5pUSYRD3GkS6QZA",
+	"0eZyyRB4FiMuemKUHe9eveoYYyuOHxgrtn/XMn5mNxrGzNTMZVjVPcfzac+mJ6YmpkTQ3G7VzVnz4sTU",
+	"xEVa+gbS6STdt0k/8LhXdt3JsiQi76DCMI2YTVpGiktahoZJWkbEIy0jYpHgYkzySOlIH6FXN3y86tKV",
+	"jXBEFIiFsklcOwdF3AicTwNa3Ditbdbg4EgiDIEbkVfCdg32PbovjpDSupaBI+Br8Ax7Yxk1O7DFD30D",
+	"Y0JkIrC+ZYSf46/hjnGfGOjEqkuIwTi5UXhNKSmuG8fIDxEeciRy5ECcQ0Y89+tC+OdlZFr12YFxw/aD",
+	"ceTQ4wvXjLFYh+hQYiS8Fz4ySAfjQveCZdAziMVkBwgVja/3qqv4gaVSIl8IG5d1U9uG/m154zoxRoC7",
+	"vcWoX2rjWxJaShl6wqCCb3Ay05fVTD++RxE8SPileHwIN6HH9sfZG6oPASfxb/EcjfBxZGSnSHr+l/OL",
+	"K+VKaX5lfhGytwnxyN2myFOwjhxCgsjIAyufdYtuWISIk7ZkYtWlEkueDZduoWbOmh85hCz2y3Q9LaXQ",
+	"3ycaHUdJi5S+ie4fAkF9SZkIeAw5xm7kNUYfck8LvLowsBJcTlm60WefiqqmU1QLVNIbZorf4PGKCEAv",
+	"AteQQSEc62mt8srg+0saB911UkCo7ss2/JSxDOVqZ5Yr1Ovqt2OrCSXBzNSUiaUS3IAr0CkWqtRtMOu1",
+	"WePSzKqLT8ymWf+qC6xy1vhslZS6VXN2Na1Gr5rWqtmsVtseWdD41MzUzAfjU9Pj03+zMj01OwX/79f4",
+	"IIy4as5+tpqE8uNrLW8an0pgxPFv7ZlV8+HDVVfZJU01wuSBy4wyOlC2D2L1UmrHsDpJFe/v5D9w12/8",
+	"tdzyY0p9DN1M/gS8DwOLcAkBy80jkAl+Hz6huV06w7ll7ZKS/w0lDo0xzlqXl27cqCwsrsyXfjl346dT",
+	"F1Cl8kWQRB5RttjIw6p+Ygw0IscbL4OgJm4JbCmw14FFmvSLeRvGn0TqJ/7qT67Xg4322iQX1GhDNP0g",
+	"AwIaFabhmesx2ExCZ8TX+yDcMX41fr29Nl6ur7t20Pac8ZnLHxhj/oY9c/mDn662p6YuVq/fnLs6Xr4+",
+	"B3/hOgR5H4Ske4Qf2TE+Wli5fuvDysfzH15fWvp5pTx/tTS/gmM4F6xVl1STlJR5gRN9HlfbeWX8avyj",
+	"egDTwm2ZMNhXCJxFTzS+JF8rg1D3oMLZtQeVO02P3yrL4BrbGClxkfsCTJDtC6su60VZCqJabARxiVQA",
+	"Sp+WkYJdrDlJB78bwxetKB1dyjFgiDCX1CrJ2Y5/Wy6Bq7VeM2Bl8AkDU6wnPafV/AvyO4NK8B/Ir3uY",
+	"pEF7Ew8JbHovfBbPN8qbhDOCNO/rS+WVyq3yfKlyc275isEOxWjCmZ92Bcc6aw9LU3H5S3EA+hA4hg+i",
+	"5PmJVRfD36JQklqrg96AUC/NPq2qIG6Ia5NK+SWaoVqPgfUMOS6fkKawYV+LtBIeS0hpN2NwsPj1uM5B",
+	"12jV3fULeGB7CZLj0o5XSYjJAwEHL7nTvYeJIkJVEtqlfE1Yxwj/GaaJe9ojPVq49XhpUOPS1CWdnrXc",
+	"9IMFiTd8hKyB+0B4xq/jBx82aw+G4qoZjISznIje+EFExWC5UbBvag1Ftbjuw4Fi/PiMX19LTCcAvlUL",
+	"f6UqeCmlCvvquUZhlP65EKpUoINbdAnapglOF5hgTnmrRAWnuMJV3b1nN+o1wxciw4zLI5+IjI6c2k/j",
+	"qj9sF73Fe/yChFvR2tnrs1ck/oimW5cCoOHvYpC1bC5DiktsnXDKiiorHmH15D1elwdX8JMzVYVQdpBd",
+	"GuXYUTVOvB6vRYk4KTGcAyfIktyGRxG6k1KKiFGiRHoacRFFZAPH43xFUoJk3pahCjXsIqrQH1JGDGoU",
+	"DXttfKV513FVdJRKXzjpcAuUmRtzsTKzsvTz+UXLGFKHadhCh1l1U0oMVS+StRjLoLKJmJODG0gJbeqO",
+	"X/PsO8GFSN1AJceIdJnhNJlV9zRVmXWv2W5NtrwmiIX/r16vTRjsX6RKTa91OR1ZlcKsVTf+JNvFErT7",
+	"xk0AKxBhihBwQjNivaKakRXpND1JR4o0riE0nBv2OdBwTlqLQUNH5ImfoA7TsM9Eh7lhv9dh3uswZ6HD",
+	"/FlclCz95b3G8i5oLMTseYalcR1+EnrLDXsYvQUKJpcj0IU+cvVN5J0dVESmp9Sg1PxVnFysQ+gTf+Fk",
+	"3/Agk9KUgrYxWWb8CsaupDoYPM3nd+gB2Fp1o9SfDsrmXaGrR1+GgJNcZyirhhOHhwCnhorqwv7s0ZRe",
+	"xeJTzTZ6Yggpm+hVMmFIFet7OCbQj9AnkDRwTKr8eEhVI+TuN6ldRidQR0HCqOC58J8RivmGIt7sCO6c",
+	"NOY+TXQPF/o9L8TBekJ1AqUD9TTY2qcZMReZ7MoxiQ0VfUH1JNyGO6H33beOESH5X0CA4VMM67E3iQsI",
+	"Z5SgrIxPR3Cb+NujVY5/aGkRCYeiHgpqMH1JUcPa7G+3XdPtEZUOFXACuzVcFcqoEcWgphc0tB40MLCG",
+	"VooizkncYt/AXlOcmJNiY/A69PyNNGilLQDp91SG6IiXFt4V6lyi+RKpFFI4PF8UNUVl0sn6ZksU2eJ2",
+	"c1o3j+qYLtDDw2rkkjYGShh93Jw1/9r426a3Pgl5a45bW3Una2uTxt+2Z1Zd86GVSa/yELpSbBED7eHt",
+	"lIuaGmN/C9aeBPbWmJQ0KQiz0nNKdsmFgYAaaXq3z8CAUPfGazeGaEik1qctkDCtVSJf86TBbcWaOIpB",
+	"Bbz3Feucnxsck8TZq93JTmpjWZQ4mSC9tM6dZD5/EHpOFN+Xt99gu9GnSSkMt9TrglW0oiZunUQ5YM5O",
+	"IqpJ8ZKI+rg6m9JOFILzzXec9L/lCgGGeqWd1gSEofNbnAM14FQ42wdXGASk5JxcnXUvdP8+OjK+j/zh",
+	"KbS4YnWCr6zLuhcyDtYqIg7iUzymNOBn9klcC/sTal9icpkgiswS7MV8eFuGPv61+dBKv9meiV8CZp94",
+	"CWSM+fB2jnw5aUKShQON/WOQC6AHcZiapKOcQ3nA+sl59X/UMiHmPjk8v0UltSftWi1fdeS1t+dqtVH4",
+	"hFTx1Wz/TXy/+ZVJ1J1GHpBzwfPrxyY7HJ146eyhq2YXYQ7TIzKHgaWWpBrqxRjAN/KNorgI5we8hOL7",
+	"yy+FtzNa/CavdlEHa47/O9XwKfaA81ttAEEYd5qeEWzUfYMI1bDdWgSUNOwGAqsM59O6H/gn6ij/ivy1",
+	"UZ0H3i436rUW9eVInJrK9b6KSg5ruF4/HnUs8haiytQTzYVSDX4zfX4aL6qsVPGLk+CbNafhBE4h1nmN",
+	"Hh2BewJTms7hh8dOkXs7eszJs6rvUGc+JD3lfLAElVqTjCBN73wJ+dQ+kCwHGnDS3g7nVFa1jsENutMx",
+	"J02F7ePi94/nP9XaLYpmMk3NDaHoDFdQYuVBUU8uo+WS0mrgv2YKKTcnZ78ot2UE6yWHgDucAIe1i/El",
+	"iRImNSSQQc9S42a7VhPJaAP4bfzSnPTOCIw31YrAbHnj01NT01LRkFmzfSlPWS3SzqBwuZJ0EwHx6umx",
+	"cnk/vKwuL5+Q2d6GBL/2JZhN6mLlbaamn4M5V6sZvmN71Y04S3uWajE+zNvv4brLFpIv/zWgIcLZq8J5",
+	"KqdUbh/hCpz9PqNiIHFEuKOAtpZLtIozlJJxcle2GBlZhSaLkdTdOalpZIqPpT8ed7tWlEZd/Ads7Xt2",
+	"o63V2DW9VuXeslwlB02da+XihhlBk1R4RDdEZasT0/8TTRd6WkTliJPZYVHt0sxJyt1n49lVbRd63or8",
+	"WaPpEoKtFk2Jqx6pLe1Qzbds5m9ImvsXURZ39vw0bW0zLB8+6TXH8O2g7t+pO7VZo30Jdpj3aEXjqD0N",
+	"Kzg5y2e5JCiGNtLKJmv+XHpL+HWQdgZTl1R9EOswco1wgNmkQYiwA4poCpQnARmXS7Iwjjmkr5HICE4d",
+	"gNTlgE7uysZKumJ1wMKpHlU30TgpQsBwbMGXAg0ioI1I1VHDAW0gdsLA+yxXMulqIK+a4reInVHzX7RI",
+	"R2l3ruJOnIqGMZJKMUBtODfKwunpCbxG8xlrCsslAchWMAQ9Q0znLUjYk5GgOsmTZHgnL15ONAdFBzWM",
+	"GhBxTenVIKChzjvPGUv4lHoSIcYNl2GM0blfGIK3YjmLwobOVXr8pFzzI96/Y/vsRW+wO/WGo0OF/FEH",
+	"l+FhbuwFHQdBANbzu7gcsmyRstdGHOzOaIZjUMAWy2MBXt6gUi9U0xcFQvFieTXI6cjAuLyK01Jo+CNe",
+	"tvDAGENpeUFQkk7IXcH+6ayXuQiqrahQFqqW+iY0UWPHVO3JLi/wBeI6o86wWhFYVDDjdabVTGUFNN3l",
+	"iDtcAqGWqW9F8Q0+qVaBI7bqO72Iz7kRp2/D7MbUozi16eyduFFW02SyYPko2HlZqvLwS4ZUTXan4dU4",
+	"8mSsJii0XIL0rVTAJ9N0+zodO+HI8ARDDXciM0RjyWQ6InSi9zQNP3EP4+BXewZMwbpLRUQNMAVFneYG",
+	"p4S4unvST4CJbViXMVmNGlv0dNkLOqjMNkaEBu0byh5QeQkqYpC3GeX5xYWlUqU0/4tbC6WEMkUfjJcp",
+	"Fj5ruE0j+cdovWgKL5d8o3nnFC1iHSlbWQ7jI9yxJ1HBXwS2qYUeErvH0yoyt1wD8pUkMPqhsttpJqRs",
+	"hmHd50gvbvRHZNLNawWRjUOH9RkzQyiOPOKTGfiJH/3ICdKhH22aQEoOqjLtVEM0P0Z5d07sw/woD2UE",
+	"p+8Y+GSsZH1p3pJNRdSz19LrxSm8UfeD7Fyr/4OueF6BDbjxFkd3AqdWcLKdDM8RTDaafu+KZuKKq6qX",
+	"6Gyi7cmiczOlrq2Ve11v1H3NfR0yOyczFSgnH+cY7a9yQ8jLJYEKAV6d6Iijm5vSDPuYxdyWSwVi1QUd",
+	"/Lo5yqHdUWaphmN2LYX6eF5dosIc655whblvY2VbkPMREX1ckHWs9LOrFy9e/IllsN0oz7Qnw2yyEqVE",
+	"Ud07XnNTmViRIsKFZps10SPWPfZsg+ax5qrjyvENnrza9vym9wv8ZIHHb0COGX96dMEqCUGOgRhayPLd",
+	"mYOdEAXzZqbignknLIfz0BJYq7uK+6krTgxVN/EqYcVVxNJHbT73kUtGpTh/h54kfY8MarKXBOTv60oJ",
+	"J/Z3+L6vA2Ec6heKZefJ6+Tp0ucxGy/S8g/ig0sr73HaBldGpBEiRUQp7KpPrBugcKAPNyfO9bXSCmef",
+	"cq+z6/TllP5QpOGVRC1+HBtfzg5baaqN9NnBoPAVJsO/D1+dqP1B8QvBGS+PT0+Nz1xamZ6ZvXhp9vIH",
+	"J8cZuTp2XgJcYjrvbICLVw+JSzpIHOe/Ex56OUyvMoA8383C4i/nbixcq5RX5lZulbWlS1qeQQc8a3Ca",
+	"AE8VKeIxAORDAjGkYSCG4jZBv6SBYSveE0nUp8qbJR5k5cMbS1d/nvAv4atGy3OqTbdWh430DXC3bTrB",
+	"rDEN/qOZtLPNj8AsV2KPW/zH9sUT9zhxdU+N23UJdSD5kIruFS8xrjp1egOcOrxmiRwcjWuuKCJChGx0",
+	"VWi5MRqZ3RycwMcbw6ISXZ6+u02Gr2iw2ecxLF4WZgg/UosOJy77XzgWuZx68yTDkiJI9Ym53jRvn1yk",
+	"sXi0KQ6THTNCVSz6NGpcaWg5h6eGL9lurQ6GjWwWULusSxbyhwqGT83ZO3bDd6i5Q9y6bjruckaHpIBk",
+	"ZwgyLY05XWDMKWWMi2j+8LabNZyk6HPIt00F5k7jN6NHZC9/EsALOaZRO1ht02A+v2S/1BmlKVqU9yol",
+	"lakqQ77Ajs4ijwvGN4xfucIZXTvsUNul1zK4qyyqlh1X+Nb4TMixx2sSUsG4Q6zO2AF+lGgLSX2Fz4+5",
+	"IZcXigSClK2QClz9UAKPp5HbcpZBm294CadXFLaJuoomymn12CEhFDS4GsuIOlu+FHk5cmZj+Cx8VlxU",
+	"EqIi2z5MwMbDJ+pElY4kINhBW4jLVRKEI1Hck5AYXL/gQMqUtNcsUuCCeXwxV46XOFDkbK3CHyYE6D1U",
+	"89xiS/R2LE3mB2nF/iFlrSY8YwY7QpMF0QmagsDh0zzD8djmbQTVzUhweA8zjYal1smgmqRhg2xX2LhF",
+	"8QpDSTrcucK2YEm8MIIYaTZqFcWAsI4nWaAlj5Rhp21fKBo5cBUxroeZ3Dc0u18ZoChjhcD9hCfgwhWu",
+	"VewIXYUdURky6nOtoiJF/EH0b8agBH0ggS3inb5Eh6e0sqSJRCgbOAgaebz0Q/kTb19UYfbh5VMXVbCG",
+	"VsOuOrXK2gORDnxS0isxeJJeo2LnR7wMflFySB6lZ6pfKpYVnFWgVuNPewsVQqSbrrHjrAI5kFKZXU17",
+	"Cpnaf6TJkvIWv8+PLJAf6TavCrdael7hthYGqvHQ5E1tcalydW7x2sK1uZV5ZXZu04g87njTsY1r5OYz",
+	"6q5BZdloosGQ+bG8Ti50Ch2dCBaXVgYTAOx15uHr4cyp3O1EbRicLDeoFS9+Ht75VBNVEZ1sa3DLEUI3",
+	"A58cVQqTOVyX0jR4IWTRF3Sfu12o4PUe9IYA/SN8LNwxOho8NXwyLTnYcLBhbAqr3LxjRGreiSrrf8LT",
+	"3Al/HwuxZMc/mQByC7RnaexpVZwdqCpnphDnqBNxOvQYaYfctZMIQkXOto6if0afyGq5OowhsNm85wxd",
+	"j6Okvnb6JTkuvi/Jga6bH3ghDtEN4QesjZ1Llea9pnAuNIWLx9UUvhUVFYrqCacg8y++EzI/DT4UV00j",
+	"ryGgqJbWeMn6Mj4v7mPyZBiZCzHxnDjTv2pAgeRU5fhIHqn9np96LyN7Q7gQSSlMgptSDkfyahE66fRj",
+	"UrgH76GK78M3QvxqAhQ/FvjhUaKkzZmgDt9HZTKjMkqMLAMWWpDZ++21zXpQinBAGSwfm5sZotUVJKEZ",
+	"YzWn2qi7Tu2CJhs7J7f1VdprcAULILFD9c9PuP1vYfEJQzQWjzukJq3iI2pHsaVPARTTHcT6y/KOnJLl",
+	"COSPcftWy2vec2pmCro2kjHJh8+nYlphGR89GQNUfPiHK4QinKCcxWTnYO81B003LtC9eDFOZ8pAMhb8",
+	"nNTaTPma2240NAjHU5OtBYNL2poFr+I+BLz5CGpyMQvqxD8DPygSgrpd0OiXtPXzXIg+fKRMdYwfOwmc",
+	"yF6X24mhR/DCD6wu2DvqnnjbfonzY+R+naTQJGXrfNVv0NTN13SAseXWJy/jA6PKorUHlZYnCQXCm89Y",
+	"vPGlZPrdtuBhwW8/AYYqgN0X02mo+DChwRNj/00GCpxeAb6aeuVyUqjcLkwAtEv6rj3UHp3SQIT2iv3J",
+	"edWa5Fk/Z53oPqBf6yUe7svYcH+jCU6EOxam1iebgPbSGeqCHGjSEh1MtrzJz/BEHg4kiWVv2VuoZZQt",
+	"adnBRrq56WnXKil2VMulnMNKn45xTouA6KaKhKEJZqXKyaZPXnDEyc/4FRhMASJ4dMt3ilJCrAcXp4WC",
+	"3WTVTHCImy6XzlGD2MF2huMNR5dqPgfrnDmVDtNlR2OlI6DnKGrWnMUd0+vMI+PoIwNot8yfG1TF5U8R",
+	"FhfjvNjdHYvLEDxXlLTIqllxUpU1CBIJbcyOMYmgeRJTOM/1bN6ML+rrB2UW85o+0e7R6Vl9x7EF1O8c",
+	"Fe1dbY/58LEin8Mn2qcMtgsps4mH4dEeAb7eEH2Se/9R+BiQ8xkL9JqNRrulK0QTFSA9VW6n3D8dW5Eo",
+	"Le59W1h7+jrcDv9pqBHQHwauOOzSPwiXLfMcUDAHN+xbcezNEbv1bTqba5FY9SsEIROiU0mvpH8Kz0yj",
+	"XnXQN5L30oz60ofNNdSSZe25ZT8AoJpvFlaIVyIU2wlXXSVl/+1vSWRQ5PiCxFwLbFQRt8u/K5mHSh7a",
+	"EI0/cxrZrczP3dQVLI3WfZpd6hKryym4ehI9+27O3/xwvlRZ+lllaeX6fKkCS1fW3PbJPWAbRGkQ/7bd",
+	"ZrDheAjRjGGB8d/1NDHatnyHiXBbqMlDqCnaGOHAx74LbFdGpe0nbD6UgwL8Bkm2ECiOMuheGREju0lL",
+	"CbegBmnVuZBfpVP+SLgDb4U76mx5FHssplWITUxiSwyKnnHsd1Zac5fty5US4LIkmS/NOScyA74v6O4v",
+	"MnXi4rn6TiDKXMFvaqXWJTVT/F5TYzC1PBhlwkidZeaRUaqkdGKsx0M64Taf9gGqYMZv8KBmDWBhv7FQ",
+	"PSTFwbg09RPjNzoy/03U50MJkz1JVFMU39+T8zB5hAn9ypBPjFPSHLoMcOimF5eTXosWQ5wlgD6PVHWP",
+	"iVVXG6TiopaTxAgCF3dVCIbNiMLyZMZFVWZc3bC9Rp06wA2UpKrY4B8HQr5jtxtBVOkgM/dDpKBlwUkt",
+	"KXwIkcKhuIe2Qn28J4OEGz8MdR8GhdDiR6NPnUnMDAy2ik7MW6bwbzvUUpBwtzUsiHEmOkkWfeWoatnw",
+	"27xDowPDZuWO327wjS5q+6cqYr6NWJACtg6fGLECwzGifGeoFKUqHchOeETrO3unyr/nl5dgnaHVHz8m",
+	"zaLRl0H8IKdW1ehq1cVstep9TolIUsqs0cFTFGKQyM4PJzvkmxyVrbA4QywlqU2/kYBbaEdZgj9oGqEd",
+	"UYgZnemKcgRZ+AN7ZevlcpJdhjvRBN6khXtXSjfJ1hzz1OWag+JlYD8jePFa/OwIilRGJabMxtku1XmC",
+	"g3fcmu0GusojXykqYk94eXCrSbUkGympmGLG1PE8aWkN6FjazJkoMfEpU3Ksj6HTLP1luvAFjSkiRzEA",
+	"Tvwq3CH/G5xJhkb/g5Gs7wXSj1cgpRgReKiw3pqmTkVhElgu5VKBJKMGJJIesD4fNKrKBTIMsJ4yeEwv",
+	"wbJZbMRC9Qw2mQKpT9LEr06GO0qX/USsT/W8p6TZgAYr8Ly2s8qA2u7w3qK96cTF4FPZHYj+DneSAicd",
+	"yckRKvxeSHtJewzIrMcZsRudeDyFQM45ijxodYZigYdkbXD2IvyfBA5MHNS5FEUDGvsXdL3mXZ9E9xbt",
+	"/SnU6gQmt8cL5/QJiNITvCV5J8ZED8UdqGoJd8fg6KYeH6GTFZkV/8yFipzjNg9KXwTTefB33q9/dbe+",
+	"VP+7+t9/vOj9+uPLdxfcKZPIn/aZLk6F30EBg7tkmYlfLuMJOW6guK4cd73uOo5HMGc9GO5dauPA96Vg",
+	"+wag3ijKPKB9A418rLYNMnlbfJGwKRhilqLPHOHJ+tLdOEcVWAs2d1BtJnU1lNbIc3jEFn2OcZB9KkW0",
+	"xyEN+3lcKa75m8eXlumpEUX7yFf6ZMoSFxdsfNmZvpHsVohjaUiJHhojhwpU5whw5ndQUip14sMdej25",
+	"GWnFc7BXxRrgQIlo9NjOk2HIC8DRTsOpwliVTTKmGo7tBxWep6sECRp2ALCm41PfSbgsMlNoIhZQeEaF",
+	"WLdUVfh5hAhS6WMYIMUpMuWcmZ3ry/cH4hWnfPEiWUE+rIEAgMwwRypfEgvQEjQMOxdGZQLSNu2ezvSP",
+	"kzVPPb5+wgF1Iy5xzjqJiYePhctBrjiAv2PVlbhOveC5cnYVFiXAZnlSLVs5IfcpttZ7HT7HXpB8hGwv",
+	"uOIsiz39VBdaMYvyUAIlmXRO2L9dvO5Rng95iIRTOVp+prWOCobLp2V380mFy7MdBW8j7J1o1nCuo95v",
+	"XZAMW6fpuM3cSW8qGvSWOk4k2V9+G6m5WyvXl0qV63PlCiDZK8ulcjrmTXPxDdDGDEijNkS/QAx5b7b9",
+	"wKg2mj52P6c+R8GGs2nBP9ccA28PpCfKwXJRC2KY0L7oxRQB2ArH9iGbEhGiFOTXhvUxmVIK3YNLXg7r",
+	"J//+PrT/PpIyOJdIE99PhfTzLu9JhfX/mNZ0MgP76ZLh+Zqs0ALybcqSw8X8sRUWKBcsy81q03NMa9g4",
+	"fWKUz/TeumOA/dSBT0+JeSuQ/Yi+hNNMuAd/ePD9rbhk/+vYSYiN5rOR/dE1Fc930qX735FwjeacNYGb",
+	"PJbgO8EyOvUHc4Vy9Ogo1XiGiSAUgTGnB9RA80WaO9r+e3EncOS08Ae139YVqXBfwp15kCBANIl5iS5h",
+	"XsvPkFsaDip8zI6EZJCisFkxiHME+nm7XAyNchk6c5b2zh/Z6/BZyjGT5BasL3hKksAoB2YLdalOknrg",
+	"DWgmYojSDaLIc/icRP6e/MI5sacKXqFh+JfkdkmgFHfZa/63LjJ7/c6IKpb4hzxXjVIpW7qVPbYf30lQ",
+	"eZ9msEzEuUE60C3RUrLeqAcP8nknVC7w51LvjOKxd2t+xVaa8F9amZKa8EetL+/ZNIrkKombbPKaCIHt",
+	"BYnhpqeU4YrWRIvmVSz/O57nZ/o/qdP9rEhXEq4w57tEs7JXqK+gxH16om4gDH0kPLhaoKa0jUVXX9jz",
+	"phR241+xot0+HuOfHiV04nj1Zm0Qg0nQe8FepdK+y7mn5yiKLZHGO1QNQwMWVyi8m2zSOSDZyxjjBc2g",
+	"QuaBBRpTnxfZwIA87JWcVIlcUGGjNafhBM7QnPSa7rURmGk9hU5OgMVryfIMH1zSlmdQruyp+sbfwr38",
+	"jhCUURPOt38PVOrNpf3vFPhnQcrPpd11R6rVmgUhwdc+ip4cFkZCFY+K40PjjF2q/h07X6mfPEi3/ax6",
+	"JNDj3ByuQFLuBKwiIlf42MinRiFAkLWPhYoZxUtpKa81nTtz6sE4pjVUbSReg3V0wI5cv5SO+jTKn1Y0",
+	"VUZTheuOU862OPxNLte70fQCXSvcY2g56mSKIeYk/Nhy6a8oxJ9Bdm9Hl1DTP/usmyZmWU8dhDhaLv1V",
+	"+MQyUF/t5ka5ClW3zGV1aRk9iOWlxPMIrO/2KcjI4iSelJYnQ+B8EreHVInDJ3kC610qzaYSs1R/HW4B",
+	"qJ0vIGkj/uVNwU0o0usqTeODQOr4RiGU+p/lwhlJSE0vHavRllKTIhXDCORU8TQJkCOrNbpvxsiI3EwP",
+	"qwAsP+rEmXCz59oSPy68/juDhueJnUV5pc9LcOTB4GnI48HgM8v3FAPFK8iUdwoRn7lwVPTlSnTcF8ov",
+	"GwGGBol43wmu2i27WswAL0tPj1Jtzv4UQcOxg28m0mzvO/X1jcCcnZq4XFyFTQ+YukH/iRxxC0GPOxAy",
+	"JMd7H5sodZU62bL5ko71Z3b75nGjPpZT7kTNj6hVZNprkFpxaspfk7pIxZlIvgriQW3SGJuyjOmpqdtZ",
+	"UwKLIvZHNttrDckZ6bZF2ZyhtZizd2+0/cG1gIgFFXJpfC3Vz1LcGlql5hwxjUPq5iVFspES3iH9T0Fw",
+	"S8spdh3FgnP8ChyUTL33AajVHVRxU8sVF/w5DhctwhWjp0fxRsYIVZ6CUZT9SW9+polPHMNKiUc8E5iv",
+	"uN7pLRgIf9al+OZs1TlgJO/ENf0uhW94CsrGIXQL0BoXx7O+fCcoNRsFLxk+OcIF85qNGJs4RGzT4zPM",
+	"dSPCM8e7azj827pmFAhO7swP8Na9XZ/f2y35diLCmpA44VOUqfza5wBUB137cnXDqbWLXv3o6RGuvy99",
+	"EeLylX9suuhf9+v25N87d+3A8eruWtsDd/r9pne3UrMf4AI2EUURtOHz97GDWLDRBt3aq5u3+bNwRWbN",
+	"6Z/MTk2J1zFiDz8CmMJ8WJzhyDPNO/mPm97daGeOiSoQr7/X6d9eB7FOuMUt/g4FA95VFoE+Txyhz/c+",
+	"fAbKOmLHOqiPf07BDnySqhAfj33crTcafkHmQc+Owjr41z4x17HXxW8b5hABNj+aa+RKS+M+R48s8M+8",
+	"XU1Cv1Pv9YnTcQccYVrKE3bwjjEMqS6YtIjhjYj7ztpGs3nXT/bISCfmsxfYnh2B3HtxFjSMHSVHLy+V",
+	"V8bRfQY+hH64xXEHFKM6xH995txz3MAymtVq2/Owp6dl1OzAfmiI1rlQM/MQp89rJK26vxr/mGY6Pk+v",
+	"xz9ccxr1e473AF6Pfy3X1107aHuOMeZv2DOXP/jpantq6mL1+s25q+Pl63Mzlz8Qs+J5aL5T9ZwAn3Iu",
+	"TKy6mOu1w0uiHCESUll4tNs8rUnaBwSQoLP0DbUFYkeY0y06KSFvp6odXUypRnhJVjI0X5I/YocS3Hef",
+	"mohOVD0Hik+aFvyD96OEJqq4B+as6V/E/7DMttcwZ82NIGj5s5OT1foEH3Gi2tycJNKJ29bmwVv51wtG",
+	"Q/ia8bB13F1MNB3noeQvgXQmpaB3BdEyfarCB+cXPhZ5lgiT3EUd4gvWEQUCtPEcr6H54L+wF0jn8FnB",
+	"VmC7xvwLxq3SjYEdXWHUaD2W2Kezx6VyTlDwYMrttXgXiqJT4wM5GKE7ypkU9ZAmqou/iz+T1nardIPH",
+	"BZWGoRylEjNOiQeLO51kw4TrzNfKxLsE5nwP3zwb8lVAnBmE8jZUCPlKFcgbScE5pRF0WSPfY27HEetw",
+	"8U1pJLIc7LODYoQNUpqfVhZWRCJt8fQwoJGUfI5hnOpZZSIfJbqIzftNu+A1GLolX3LCwzfoi/GLfIdR",
+	"qN+x6w2nVqw9n7ZXJ+pwuFkQJ89s0nd5yuJExxHs0O/YuDx1or37ThYmohLiMMqIUDQHojSkTxSCanyV",
+	"IIJzYx7J4IgkI/m3mDGk+IEVpxajDDyKenR2CrAK+RIW4hZl5YXTECtD04oqYAbQS/SR28PrUb1C1fWk",
+	"50lTKaSYPIx+/kzcX0roe2hFP5AxKf2gdDCXfl+671LtHuVhXk9E+imagPTb/D2eyRz9sgDcgahB+f26",
+	"YzeCDSib+v8GAGCWNYRzWQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
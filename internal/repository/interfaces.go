@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"assigning-reviewers-for-pr/internal/entities"
 )
@@ -16,20 +17,93 @@ type LifecycleInterface interface {
 // UserInterface exposes user-related operations.
 type UserInterface interface {
 	SetUserActive(ctx context.Context, userID string, isActive bool) (*entities.User, error)
-	GetUserReviews(ctx context.Context, userID string) ([]entities.PullRequestShort, error)
+	SetUserSkills(ctx context.Context, userID string, skills []string) (*entities.User, error)
+	SetUserSchedule(ctx context.Context, userID string, schedule entities.WorkSchedule) (*entities.User, error)
+	SetUserCapacity(ctx context.Context, userID string, capacity entities.ReviewCapacity) (*entities.User, error)
+	SetUserRole(ctx context.Context, userID string, role entities.Role) (*entities.User, error)
+	GetUserReviews(ctx context.Context, userID, label string, state entities.ReviewState) ([]entities.PullRequestShort, error)
+	ListUsers(ctx context.Context, filter entities.UserFilter) ([]entities.User, error)
+}
+
+// AvailabilityInterface exposes out-of-office periods storage.
+type AvailabilityInterface interface {
+	AddUnavailability(ctx context.Context, period entities.Unavailability) (*entities.Unavailability, error)
+	ListUnavailability(ctx context.Context, userID string) ([]entities.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) (*entities.Unavailability, error)
+	ClaimStartedUnavailability(ctx context.Context) ([]entities.Unavailability, error)
+	ReleaseUnavailabilityClaim(ctx context.Context, id int64) error
 }
 
 // TeamInterface exposes team-related operations.
 type TeamInterface interface {
 	CreateTeam(ctx context.Context, team entities.Team) (*entities.Team, error)
 	GetTeam(ctx context.Context, name string) (*entities.Team, error)
+	ListTeams(ctx context.Context, filter entities.TeamFilter) ([]entities.TeamSummary, error)
+	AddTeamMember(ctx context.Context, teamName string, member entities.User, force bool, sel entities.ReviewerSelector) (entities.MembershipResult, error)
+	RemoveTeamMember(ctx context.Context, teamName, userID string, sel entities.ReviewerSelector) (entities.MembershipResult, error)
+	RenameTeam(ctx context.Context, name, newName string) (*entities.Team, error)
+	SetTeamParent(ctx context.Context, name, parent string) (*entities.Team, error)
+	ListTeamDescendants(ctx context.Context, name string) ([]entities.Team, error)
+	GetTeamPolicy(ctx context.Context, teamName string) (*entities.TeamPolicy, error)
+	SetTeamPolicy(ctx context.Context, policy entities.TeamPolicy) (*entities.TeamPolicy, error)
 }
 
 // PullRequestInterface exposes PR-related operations.
 type PullRequestInterface interface {
-	CreatePR(ctx context.Context, pr entities.PullRequest) (*entities.PullRequest, error)
+	CreatePR(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.PullRequest, error)
+	PreviewAssignment(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.AssignmentPreview, error)
+	GetPR(ctx context.Context, prID string) (*entities.PullRequest, error)
+	ListPRs(ctx context.Context, filter entities.PullRequestFilter) ([]entities.PullRequest, error)
 	MergePR(ctx context.Context, prID string) (*entities.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldUserID string) (*entities.PullRequest, string, error)
+	RecordPRMerge(ctx context.Context, prID string) (*entities.PullRequest, error)
+	MarkPRReady(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.PullRequest, error)
+	ClosePR(ctx context.Context, prID string) (*entities.PullRequest, error)
+	ReopenPR(ctx context.Context, prID string, sel entities.ReviewerSelector) (*entities.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldUserID string, sel entities.ReviewerSelector) (*entities.PullRequest, string, error)
+	ReplaceReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entities.PullRequest, error)
+	AddReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error)
+	SubmitReview(ctx context.Context, prID, userID string, state entities.ReviewState, sel entities.ReviewerSelector) (*entities.PullRequest, string, error)
+	ListStaleReviews(ctx context.Context, sla time.Duration) ([]entities.StaleReview, error)
+	ReassignStaleReview(ctx context.Context, prID, userID string, sla time.Duration, sel entities.ReviewerSelector) (*entities.PullRequest, string, error)
+}
+
+// OwnershipInterface exposes code ownership rules storage.
+type OwnershipInterface interface {
+	ListOwnershipRules(ctx context.Context) ([]entities.OwnershipRule, error)
+	ReplaceOwnershipRules(ctx context.Context, rules []entities.OwnershipRule) ([]entities.OwnershipRule, error)
+}
+
+// PairingInterface exposes author/reviewer pairing rules storage.
+type PairingInterface interface {
+	AddPairingRule(ctx context.Context, rule entities.PairingRule) (*entities.PairingRule, error)
+	ListPairingRules(ctx context.Context, userID string) ([]entities.PairingRule, error)
+	DeletePairingRule(ctx context.Context, id int64) (*entities.PairingRule, error)
+}
+
+// WebhookInterface exposes webhook subscriptions and the delivery outbox.
+type WebhookInterface interface {
+	AddWebhookSubscription(ctx context.Context, sub entities.WebhookSubscription) (*entities.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]entities.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id int64) (*entities.WebhookSubscription, error)
+	ListWebhookDeliveries(ctx context.Context, filter entities.DeliveryFilter) ([]entities.WebhookDelivery, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, attempt entities.WebhookAttempt) error
+}
+
+// EventInterface exposes the persisted domain event log.
+type EventInterface interface {
+	ListEvents(ctx context.Context, afterID int64, filter entities.EventFilter, limit int) ([]entities.Event, error)
+	LastEventID(ctx context.Context) (int64, error)
+	PruneEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+// CodeHostSyncInterface exposes the outbox of reviewer pushes to code hosts.
+type CodeHostSyncInterface interface {
+	ListCodeHostSyncs(ctx context.Context, filter entities.CodeHostSyncFilter) ([]entities.CodeHostSync, error)
+	ClaimCodeHostSyncs(ctx context.Context, limit int, lease time.Duration) ([]entities.CodeHostSync, error)
+	RecordCodeHostSync(ctx context.Context, attempt entities.CodeHostSyncAttempt) error
+	PruneCodeHostSyncs(ctx context.Context, before time.Time) (int64, error)
 }
 
 // StatsInterface exposes aggregated statistics operations.
@@ -38,5 +112,5 @@ type StatsInterface interface {
 	StatsSummary(ctx context.Context, filter entities.StatsFilter) (entities.StatsSummary, error)
 	ReviewerStats(ctx context.Context, userID string, limit int) (entities.ReviewerStats, error)
 	PRStats(ctx context.Context, prID string) (entities.PRStats, error)
-	DeactivateTeam(ctx context.Context, teamName string) (entities.DeactivateResult, error)
+	DeactivateTeam(ctx context.Context, teamName string, withDescendants bool, sel entities.ReviewerSelector) (entities.DeactivateResult, error)
 }
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// availableFilter keeps only users of a row aliased as u who are not out of office right now.
+const availableFilter = `NOT EXISTS (
+    SELECT 1 FROM user_unavailability a
+    WHERE a.user_id = u.id AND a.starts_at <= NOW() AND a.ends_at > NOW()
+)`
+
+const (
+	unavailabilityColumns     = `id, user_id, starts_at, ends_at, reason, reassign_reviews`
+	insertUnavailabilityQuery = `
+INSERT INTO user_unavailability(user_id, starts_at, ends_at, reason, reassign_reviews)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING ` + unavailabilityColumns
+	selectUnavailabilityQuery = `SELECT ` + unavailabilityColumns + `
+FROM user_unavailability
+WHERE user_id = $1 AND ends_at > NOW()
+ORDER BY starts_at, id`
+	deleteUnavailabilityQuery = `DELETE FROM user_unavailability WHERE id = $1 RETURNING ` + unavailabilityColumns
+	claimUnavailabilityQuery  = `
+UPDATE user_unavailability SET reassigned_at = NOW()
+WHERE id IN (
+    SELECT id FROM user_unavailability
+    WHERE reassign_reviews AND reassigned_at IS NULL AND starts_at <= NOW() AND ends_at > NOW()
+    ORDER BY starts_at, id
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + unavailabilityColumns
+	releaseUnavailabilityQuery = `UPDATE user_unavailability SET reassigned_at = NULL WHERE id = $1`
+)
+
+// AddUnavailability stores an out-of-office period of a user.
+func (p *Postgres) AddUnavailability(ctx context.Context, period entities.Unavailability) (*entities.Unavailability, error) {
+	if err := p.ensureUserExists(ctx, period.UserID); err != nil {
+		return nil, err
+	}
+	res, err := scanUnavailability(p.db.QueryRow(ctx, insertUnavailabilityQuery,
+		period.UserID, period.StartsAt, period.EndsAt, period.Reason, period.ReassignReviews))
+	if err != nil {
+		p.log.Errorw("failed to insert unavailability", "error", err, "user_id", period.UserID)
+		return nil, fmt.Errorf("insert unavailability: %w", err)
+	}
+
+	p.log.Infow("unavailability added", "user_id", res.UserID, "id", res.ID, "starts_at", res.StartsAt, "ends_at", res.EndsAt)
+	return res, nil
+}
+
+// ListUnavailability returns current and upcoming out-of-office periods of a user.
+func (p *Postgres) ListUnavailability(ctx context.Context, userID string) ([]entities.Unavailability, error) {
+	if err := p.ensureUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+	rows, err := p.db.Query(ctx, selectUnavailabilityQuery, userID)
+	if err != nil {
+		p.log.Errorw("failed to select unavailability", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("select unavailability: %w", err)
+	}
+	return p.collectUnavailability(rows)
+}
+
+// DeleteUnavailability removes an out-of-office period and returns it.
+func (p *Postgres) DeleteUnavailability(ctx context.Context, id int64) (*entities.Unavailability, error) {
+	res, err := scanUnavailability(p.db.QueryRow(ctx, deleteUnavailabilityQuery, id))
+	if err != nil {
+		p.log.Errorw("failed to delete unavailability", "error", err, "id", id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrUnavailabilityNotFound
+		}
+		return nil, fmt.Errorf("delete unavailability: %w", err)
+	}
+
+	p.log.Infow("unavailability deleted", "user_id", res.UserID, "id", id)
+	return res, nil
+}
+
+// ClaimStartedUnavailability marks started periods requesting review reassignment as handled and returns them.
+// Concurrent callers never claim the same period.
+func (p *Postgres) ClaimStartedUnavailability(ctx context.Context) ([]entities.Unavailability, error) {
+	rows, err := p.db.Query(ctx, claimUnavailabilityQuery)
+	if err != nil {
+		p.log.Errorw("failed to claim started unavailability", "error", err)
+		return nil, fmt.Errorf("claim unavailability: %w", err)
+	}
+	return p.collectUnavailability(rows)
+}
+
+// ReleaseUnavailabilityClaim returns a claimed period to the pending ones, so the next run retries it.
+func (p *Postgres) ReleaseUnavailabilityClaim(ctx context.Context, id int64) error {
+	if _, err := p.db.Exec(ctx, releaseUnavailabilityQuery, id); err != nil {
+		p.log.Errorw("failed to release unavailability claim", "error", err, "id", id)
+		return fmt.Errorf("release unavailability claim: %w", err)
+	}
+	return nil
+}
+
+func (p *Postgres) ensureUserExists(ctx context.Context, userID string) error {
+	var exists bool
+	if err := p.db.QueryRow(ctx, userExistsQuery, userID).Scan(&exists); err != nil {
+		p.log.Errorw("failed to check user", "user_id", userID, "error", err)
+		return fmt.Errorf("check user: %w", err)
+	}
+	if !exists {
+		return entities.ErrUserNotFound
+	}
+	return nil
+}
+
+func (p *Postgres) collectUnavailability(rows pgx.Rows) ([]entities.Unavailability, error) {
+	defer rows.Close()
+	res := make([]entities.Unavailability, 0)
+	for rows.Next() {
+		period, err := scanUnavailability(rows)
+		if err != nil {
+			p.log.Errorw("failed to scan unavailability", "error", err)
+			return nil, fmt.Errorf("scan unavailability: %w", err)
+		}
+		res = append(res, *period)
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating unavailability", "error", err)
+		return nil, fmt.Errorf("iterate unavailability: %w", err)
+	}
+	return res, nil
+}
+
+func scanUnavailability(row pgx.Row) (*entities.Unavailability, error) {
+	var u entities.Unavailability
+	if err := row.Scan(&u.ID, &u.UserID, &u.StartsAt, &u.EndsAt, &u.Reason, &u.ReassignReviews); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
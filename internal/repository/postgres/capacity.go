@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// openReviewsColumn counts reviews of a users row aliased as u on OPEN pull requests.
+const openReviewsColumn = `(SELECT COUNT(*) FROM pr_reviewers r JOIN pull_requests pr ON pr.id = r.pr_id WHERE r.reviewer_id = u.id AND pr.status = 'OPEN')`
+
+// capacityColumns projects the review cap and weight of a users row aliased as u.
+const capacityColumns = `u.max_open_reviews, u.review_weight`
+
+// capacityFilter keeps only users of a row aliased as u whose open reviews are below their cap.
+const capacityFilter = `(u.max_open_reviews IS NULL OR ` + openReviewsColumn + ` < u.max_open_reviews)`
+
+const updateUserCapacityQuery = `UPDATE users SET max_open_reviews=$2, review_weight=$3 WHERE id=$1`
+
+// SetUserCapacity replaces the review cap and weight of a user and returns the updated domain user.
+func (p *Postgres) SetUserCapacity(ctx context.Context, userID string, capacity entities.ReviewCapacity) (*entities.User, error) {
+	tag, err := p.db.Exec(ctx, updateUserCapacityQuery, userID, capacity.MaxOpenReviews, capacity.Weight)
+	if err != nil {
+		p.log.Errorw("failed to update user capacity", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("update user capacity: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, entities.ErrUserNotFound
+	}
+
+	p.log.Infow("user capacity updated", "user_id", userID, "max_open_reviews", capacity.MaxOpenReviews, "review_weight", capacity.Weight)
+	return p.getUser(ctx, userID)
+}
+
+// capacityScan receives capacityColumns.
+type capacityScan struct {
+	maxOpen *int32
+	weight  float64
+}
+
+func (s *capacityScan) dest() []any {
+	return []any{&s.maxOpen, &s.weight}
+}
+
+func (s *capacityScan) capacity() entities.ReviewCapacity {
+	res := entities.ReviewCapacity{Weight: s.weight}
+	if s.maxOpen != nil {
+		maxOpen := int(*s.maxOpen)
+		res.MaxOpenReviews = &maxOpen
+	}
+	return res
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	codeHostSyncColumns = `s.pr_id, s.status, s.version, s.attempts, s.synced_reviewers, s.response_code, s.last_error,
+s.next_attempt_at, s.last_attempt_at, s.synced_at, s.updated_at`
+	// A new reviewer change bumps the version and restarts the retries of a sync in any state.
+	requestCodeHostSyncQuery = `
+INSERT INTO codehost_syncs(pr_id) VALUES ($1)
+ON CONFLICT (pr_id) DO UPDATE
+SET status = 'pending',
+    version = codehost_syncs.version + 1,
+    attempts = 0,
+    next_attempt_at = NOW(),
+    updated_at = NOW()`
+	// Claimed syncs are leased like webhook deliveries.
+	claimCodeHostSyncsQuery = `
+WITH due AS (
+    SELECT pr_id FROM codehost_syncs
+    WHERE status='pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at, pr_id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+), claimed AS (
+    UPDATE codehost_syncs s
+    SET next_attempt_at = NOW() + make_interval(secs => $2)
+    FROM due
+    WHERE s.pr_id = due.pr_id
+    RETURNING s.*
+)
+SELECT ` + codeHostSyncColumns + `, pr.status,
+    ARRAY(SELECT r.reviewer_id FROM pr_reviewers r WHERE r.pr_id = s.pr_id ORDER BY r.reviewer_id)
+FROM claimed s
+JOIN pull_requests pr ON pr.id = s.pr_id
+ORDER BY s.pr_id`
+	// An attempt that raced with a newer reviewer change leaves the sync pending for another run.
+	recordCodeHostSyncQuery = `
+UPDATE codehost_syncs
+SET attempts = CASE WHEN version = $2 THEN attempts + 1 ELSE 0 END,
+    status = CASE WHEN version = $2 THEN $3 ELSE 'pending' END,
+    synced_reviewers = COALESCE($4::text[], synced_reviewers),
+    response_code = $5,
+    last_error = NULLIF($6::text, ''),
+    last_attempt_at = NOW(),
+    synced_at = CASE WHEN $3 = 'synced' THEN NOW() ELSE synced_at END,
+    next_attempt_at = CASE WHEN version = $2 THEN NOW() + make_interval(secs => $7) ELSE NOW() END,
+    updated_at = NOW()
+WHERE pr_id = $1`
+	pruneCodeHostSyncsQuery = `DELETE FROM codehost_syncs WHERE status <> 'pending' AND updated_at < $1`
+)
+
+// ListCodeHostSyncs returns the most recently changed syncs matching filter.
+func (p *Postgres) ListCodeHostSyncs(ctx context.Context, filter entities.CodeHostSyncFilter) ([]entities.CodeHostSync, error) {
+	var b strings.Builder
+	b.WriteString(`SELECT ` + codeHostSyncColumns + ` FROM codehost_syncs s`)
+	conditions := make([]string, 0, 2)
+	args := make([]any, 0, 3)
+	if filter.PRID != "" {
+		args = append(args, filter.PRID)
+		conditions = append(conditions, "s.pr_id = $"+strconv.Itoa(len(args)))
+	}
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		conditions = append(conditions, "s.status = $"+strconv.Itoa(len(args)))
+	}
+	if len(conditions) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, filter.Limit)
+	b.WriteString(" ORDER BY s.updated_at DESC, s.pr_id LIMIT $" + strconv.Itoa(len(args)))
+
+	rows, err := p.db.Query(ctx, b.String(), args...)
+	if err != nil {
+		p.log.Errorw("failed to select code host syncs", "error", err)
+		return nil, fmt.Errorf("select code host syncs: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.CodeHostSync, error) {
+		var s entities.CodeHostSync
+		err := row.Scan(codeHostSyncFields(&s)...)
+		return s, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan code host syncs", "error", err)
+		return nil, fmt.Errorf("scan code host syncs: %w", err)
+	}
+	return res, nil
+}
+
+// ClaimCodeHostSyncs leases up to limit due syncs for lease and loads the current reviewers of their PRs.
+func (p *Postgres) ClaimCodeHostSyncs(ctx context.Context, limit int, lease time.Duration) ([]entities.CodeHostSync, error) {
+	rows, err := p.db.Query(ctx, claimCodeHostSyncsQuery, limit, lease.Seconds())
+	if err != nil {
+		p.log.Errorw("failed to claim code host syncs", "error", err)
+		return nil, fmt.Errorf("claim code host syncs: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.CodeHostSync, error) {
+		var s entities.CodeHostSync
+		err := row.Scan(append(codeHostSyncFields(&s), &s.PRStatus, &s.Reviewers)...)
+		return s, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan claimed code host syncs", "error", err)
+		return nil, fmt.Errorf("scan claimed code host syncs: %w", err)
+	}
+	return res, nil
+}
+
+// RecordCodeHostSync stores the outcome of one push attempt.
+func (p *Postgres) RecordCodeHostSync(ctx context.Context, attempt entities.CodeHostSyncAttempt) error {
+	if _, err := p.db.Exec(ctx, recordCodeHostSyncQuery, attempt.PRID, attempt.Version, attempt.Status,
+		attempt.SyncedReviewers, attempt.ResponseCode, attempt.Error, attempt.RetryIn.Seconds()); err != nil {
+		p.log.Errorw("failed to record code host sync", "error", err, "pr_id", attempt.PRID)
+		return fmt.Errorf("record code host sync: %w", err)
+	}
+	return nil
+}
+
+// PruneCodeHostSyncs deletes finished syncs last changed before the given time; pending ones are kept.
+// It returns the number of deleted syncs.
+func (p *Postgres) PruneCodeHostSyncs(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.db.Exec(ctx, pruneCodeHostSyncsQuery, before)
+	if err != nil {
+		p.log.Errorw("failed to prune code host syncs", "error", err)
+		return 0, fmt.Errorf("prune code host syncs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// requestCodeHostSync marks the reviewers of prID for pushing to the code host once tx commits.
+// It does nothing when pushing is disabled.
+func (p *Postgres) requestCodeHostSync(ctx context.Context, tx pgx.Tx, prID string) error {
+	if !p.codeHostSync {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, requestCodeHostSyncQuery, prID); err != nil {
+		p.log.Errorw("failed to request code host sync", "error", err, "pr_id", prID)
+		return fmt.Errorf("request code host sync: %w", err)
+	}
+	return nil
+}
+
+// codeHostSyncFields lists scan targets matching codeHostSyncColumns.
+func codeHostSyncFields(s *entities.CodeHostSync) []any {
+	return []any{&s.PRID, &s.Status, &s.Version, &s.Attempts, &s.SyncedReviewers, &s.ResponseCode, &s.LastError,
+		&s.NextAttemptAt, &s.LastAttemptAt, &s.SyncedAt, &s.UpdatedAt}
+}
@@ -20,14 +20,17 @@ type Postgres struct {
 	log     *zap.SugaredLogger
 	db      *pgxpool.Pool
 	cfg     config.PostgresConfig
+	// codeHostSync enables queueing reviewer pushes; without a syncer they would never be sent.
+	codeHostSync bool
 }
 
 // New creates a Postgres repository instance.
 func New(ctx context.Context, log *zap.SugaredLogger, cfg *config.Config) *Postgres {
 	return &Postgres{
-		baseCtx: ctx,
-		log:     log.Named("repo.postgres"),
-		cfg:     cfg.Postgres,
+		baseCtx:      ctx,
+		log:          log.Named("repo.postgres"),
+		cfg:          cfg.Postgres,
+		codeHostSync: cfg.GitHub.SyncEnabled(),
 	}
 }
 
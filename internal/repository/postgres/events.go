@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// publishEventQuery appends the event to the log, tagged with the given users and their
+	// current teams plus extra teams, and queues a webhook delivery for every subscriber.
+	publishEventQuery = `
+WITH ev AS (
+    INSERT INTO domain_events(event_type, payload, user_ids, team_names)
+    SELECT $1, $2::jsonb, $3::text[], ARRAY(
+        SELECT t.name FROM users u JOIN teams t ON t.id = u.team_id WHERE u.id = ANY($3::text[])
+        UNION
+        SELECT unnest($4::text[])
+        ORDER BY 1
+    )
+    RETURNING id
+)
+INSERT INTO webhook_deliveries(subscription_id, event_id)
+SELECT s.id, ev.id FROM webhook_subscriptions s, ev WHERE $1 = ANY(s.events)`
+	selectEventsQuery = `
+SELECT id, event_type, payload, user_ids, team_names, created_at
+FROM domain_events
+WHERE id > $1
+  AND ($2 = '' OR $2 = ANY(user_ids))
+  AND ($3 = '' OR $3 = ANY(team_names))
+ORDER BY id
+LIMIT $4`
+	selectLastEventIDQuery = `SELECT COALESCE(MAX(id), 0) FROM domain_events`
+	// The latest event is always kept so LastEventID survives a restart, and events with
+	// pending webhook deliveries are kept until those are settled.
+	pruneEventsQuery = `
+DELETE FROM domain_events e
+WHERE e.created_at < $1
+  AND e.id < (SELECT MAX(id) FROM domain_events)
+  AND NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.event_id = e.id AND d.status = 'pending')`
+)
+
+// ListEvents returns up to limit events after afterID matching filter, oldest first.
+func (p *Postgres) ListEvents(ctx context.Context, afterID int64, filter entities.EventFilter, limit int) ([]entities.Event, error) {
+	rows, err := p.db.Query(ctx, selectEventsQuery, afterID, filter.UserID, filter.TeamName, limit)
+	if err != nil {
+		p.log.Errorw("failed to select events", "error", err, "after_id", afterID)
+		return nil, fmt.Errorf("select events: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.Event, error) {
+		var e entities.Event
+		err := row.Scan(&e.ID, &e.Type, &e.Payload, &e.UserIDs, &e.TeamNames, &e.CreatedAt)
+		return e, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan events", "error", err)
+		return nil, fmt.Errorf("scan events: %w", err)
+	}
+	return res, nil
+}
+
+// LastEventID returns the ID of the latest event, or 0 when the log is empty.
+func (p *Postgres) LastEventID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := p.db.QueryRow(ctx, selectLastEventIDQuery).Scan(&id); err != nil {
+		p.log.Errorw("failed to select last event id", "error", err)
+		return 0, fmt.Errorf("select last event id: %w", err)
+	}
+	return id, nil
+}
+
+// PruneEvents deletes events logged before the given time together with their settled
+// webhook deliveries and returns how many events were deleted.
+func (p *Postgres) PruneEvents(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.db.Exec(ctx, pruneEventsQuery, before)
+	if err != nil {
+		p.log.Errorw("failed to prune events", "error", err, "before", before)
+		return 0, fmt.Errorf("prune events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// publishEvent records event in tx for the event stream and webhook subscribers.
+// users are the users the event concerns; teams are added to their teams.
+func (p *Postgres) publishEvent(ctx context.Context, tx pgx.Tx, event entities.WebhookEvent, data any, users, teams []string) error {
+	payload, err := json.Marshal(entities.WebhookPayload{Event: event, OccurredAt: time.Now().UTC(), Data: data})
+	if err != nil {
+		return fmt.Errorf("marshal event payload: %w", err)
+	}
+	if _, err := tx.Exec(ctx, publishEventQuery, string(event), payload, uniqueIDs(users), teams); err != nil {
+		p.log.Errorw("failed to publish event", "error", err, "event", event)
+		return fmt.Errorf("publish event: %w", err)
+	}
+	return nil
+}
+
+// publishReviewersAssigned emits reviewer.assigned for each of reviewers of pr
+// and requests pushing the new reviewers to the code host.
+func (p *Postgres) publishReviewersAssigned(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, reviewers []string) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+	for _, r := range reviewers {
+		change := entities.WebhookReviewerChange{PRID: pr.ID, NewReviewerID: r}
+		if err := p.publishEvent(ctx, tx, entities.EventReviewerAssigned, change, []string{pr.AuthorID, r}, nil); err != nil {
+			return err
+		}
+	}
+	return p.requestCodeHostSync(ctx, tx, pr.ID)
+}
+
+// publishPREvent emits a PR-level event concerning the author and reviewers of pr.
+func (p *Postgres) publishPREvent(ctx context.Context, tx pgx.Tx, event entities.WebhookEvent, pr entities.PullRequest) error {
+	return p.publishEvent(ctx, tx, event, entities.NewWebhookPR(pr), append([]string{pr.AuthorID}, pr.Reviewers...), nil)
+}
+
+// publishReviewerChange emits reviewer.reassigned concerning the author and both reviewers
+// and requests pushing the swap to the code host.
+func (p *Postgres) publishReviewerChange(ctx context.Context, tx pgx.Tx, authorID string, change entities.WebhookReviewerChange) error {
+	users := []string{authorID, change.OldReviewerID, change.NewReviewerID}
+	if err := p.publishEvent(ctx, tx, entities.EventReviewerReassigned, change, users, nil); err != nil {
+		return err
+	}
+	return p.requestCodeHostSync(ctx, tx, change.PRID)
+}
+
+// publishTeamDeactivated emits team.deactivated concerning the team and its deactivated users.
+func (p *Postgres) publishTeamDeactivated(ctx context.Context, tx pgx.Tx, teamName string, deactivated []string, res entities.DeactivateResult) error {
+	data := entities.WebhookTeamDeactivation{TeamName: teamName, DeactivateResult: res}
+	return p.publishEvent(ctx, tx, entities.EventTeamDeactivated, data, deactivated, []string{teamName})
+}
+
+// uniqueIDs drops empty and repeated ids, keeping the order.
+func uniqueIDs(ids []string) []string {
+	res := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// Each fallback team comes with the ids of its whole subtree; UNION stops the walk on a cycle.
+	selectFallbackTeamsQuery = `
+WITH RECURSIVE subtree AS (
+    SELECT position, fallback_team_id AS id FROM team_fallbacks WHERE team_id=$1
+    UNION
+    SELECT s.position, c.id FROM teams c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT t.id, t.name, ARRAY_AGG(s.id ORDER BY s.id)::bigint[]
+FROM team_fallbacks f
+JOIN teams t ON t.id = f.fallback_team_id
+JOIN subtree s ON s.position = f.position
+WHERE f.team_id=$1
+GROUP BY f.position, t.id, t.name
+ORDER BY f.position`
+	deleteFallbackTeamsQuery   = `DELETE FROM team_fallbacks WHERE team_id=$1`
+	insertFallbackTeamQuery    = `INSERT INTO team_fallbacks(team_id, position, fallback_team_id) VALUES ($1,$2,$3)`
+	selectPRFallbackTeamsQuery = `
+SELECT t.name
+FROM pr_reviewers r
+JOIN teams t ON t.id = r.fallback_team_id
+WHERE r.pr_id=$1
+GROUP BY t.name
+ORDER BY t.name`
+	selectSubtreeCandidatesQuery = `SELECT ` + candidateColumns + `
+FROM users u
+WHERE u.team_id = ANY($1::bigint[]) AND u.is_active=true AND u.id <> $2 AND ` + availableFilter + ` AND ` + capacityFilter
+)
+
+// teamRef identifies a team by both its id and name.
+type teamRef struct {
+	id   int64
+	name string
+	// subtree holds the ids of the team and its descendants; a fallback team lends reviewers from all of them.
+	subtree []int64
+}
+
+// fallbackPick is a reviewer drawn from a fallback team.
+type fallbackPick struct {
+	userID string
+	team   teamRef
+}
+
+// readFallbackTeams returns the fallback teams of a team in order, each with its subtree.
+// The hierarchy is locked in shared mode until tx ends, so the subtrees stay valid while
+// reviewers are drawn from them.
+func (p *Postgres) readFallbackTeams(ctx context.Context, tx pgx.Tx, teamID int64) ([]teamRef, error) {
+	if _, err := tx.Exec(ctx, lockHierarchySharedQuery, hierarchyLockClass); err != nil {
+		p.log.Errorw("failed to lock team hierarchy", "team_id", teamID, "error", err)
+		return nil, fmt.Errorf("lock team hierarchy: %w", err)
+	}
+	return p.selectFallbackTeams(ctx, tx, teamID)
+}
+
+// selectFallbackTeams is readFallbackTeams without the hierarchy lock, for snapshot reads.
+func (p *Postgres) selectFallbackTeams(ctx context.Context, tx pgx.Tx, teamID int64) ([]teamRef, error) {
+	rows, err := tx.Query(ctx, selectFallbackTeamsQuery, teamID)
+	if err != nil {
+		p.log.Errorw("failed to select fallback teams", "team_id", teamID, "error", err)
+		return nil, fmt.Errorf("select fallback teams: %w", err)
+	}
+	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (teamRef, error) {
+		var t teamRef
+		err := row.Scan(&t.id, &t.name, &t.subtree)
+		return t, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan fallback teams", "team_id", teamID, "error", err)
+		return nil, fmt.Errorf("scan fallback teams: %w", err)
+	}
+	return teams, nil
+}
+
+// replaceFallbackTeams stores the ordered fallback list of a team, resolving team names.
+func (p *Postgres) replaceFallbackTeams(ctx context.Context, tx pgx.Tx, teamID int64, names []string) error {
+	if _, err := tx.Exec(ctx, deleteFallbackTeamsQuery, teamID); err != nil {
+		p.log.Errorw("failed to delete fallback teams", "team_id", teamID, "error", err)
+		return fmt.Errorf("delete fallback teams: %w", err)
+	}
+	for i, name := range names {
+		var fallbackID int64
+		if err := tx.QueryRow(ctx, selectTeamIDQuery, name).Scan(&fallbackID); err != nil {
+			p.log.Errorw("failed to get fallback team id", "team", name, "error", err)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%w: fallback team %q", entities.ErrTeamNotFound, name)
+			}
+			return fmt.Errorf("get fallback team: %w", err)
+		}
+		if fallbackID == teamID {
+			return fmt.Errorf("%w: team cannot be its own fallback", entities.ErrInvalidArgument)
+		}
+		if _, err := tx.Exec(ctx, insertFallbackTeamQuery, teamID, i, fallbackID); err != nil {
+			p.log.Errorw("failed to insert fallback team", "team_id", teamID, "fallback", name, "error", err)
+			return fmt.Errorf("insert fallback team: %w", err)
+		}
+	}
+	return nil
+}
+
+// lockAssignmentScope locks the team, every team below its fallback teams and the extra teams
+// in ascending id order, so a later top-up from the fallbacks cannot deadlock with concurrent assignments.
+func (p *Postgres) lockAssignmentScope(ctx context.Context, tx pgx.Tx, teamID int64, fallbacks []teamRef, extra ...int64) error {
+	seen := map[int64]struct{}{teamID: {}}
+	ids := []int64{teamID}
+	add := func(id int64) {
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	for _, f := range fallbacks {
+		for _, id := range f.subtree {
+			add(id)
+		}
+	}
+	for _, id := range extra {
+		add(id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return p.lockTeamAssignments(ctx, tx, ids...)
+}
+
+// topUpFromFallbacks fills up to req.Count slots from fallback teams, trying them in order, each
+// with the members of its descendants, and skipping skipTeamID. A non-nil keep narrows each
+// team's candidates. Picked users are added to exclude.
+func (p *Postgres) topUpFromFallbacks(ctx context.Context, tx pgx.Tx, sel entities.ReviewerSelector, req entities.SelectionRequest, fallbacks []teamRef, skipTeamID int64, authorID string, exclude map[string]struct{}, keep func(entities.Candidate) bool) ([]fallbackPick, error) {
+	var picks []fallbackPick
+	for _, f := range fallbacks {
+		if req.Count <= 0 {
+			break
+		}
+		ids := make([]int64, 0, len(f.subtree))
+		for _, id := range f.subtree {
+			if id != skipTeamID {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		candidates, err := p.readCandidates(ctx, tx, selectSubtreeCandidatesQuery, exclude, ids, authorID)
+		if err != nil {
+			return nil, err
+		}
+		if keep != nil {
+			candidates = filterPool(candidates, keep)
+		}
+		req.Candidates = candidates
+		for _, id := range sel.Select(req) {
+			exclude[id] = struct{}{}
+			picks = append(picks, fallbackPick{userID: id, team: f})
+			req.Count--
+		}
+	}
+	return picks, nil
+}
+
+func (p *Postgres) readPRFallbackTeams(ctx context.Context, tx pgx.Tx, prID string) ([]string, error) {
+	rows, err := tx.Query(ctx, selectPRFallbackTeamsQuery, prID)
+	if err != nil {
+		p.log.Errorw("failed to select pr fallback teams", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("select pr fallback teams: %w", err)
+	}
+	teams, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		p.log.Errorw("failed to scan pr fallback teams", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("scan pr fallback teams: %w", err)
+	}
+	return teams, nil
+}
+
+// fallbackTeamNames lists the distinct teams of picks in pick order.
+func fallbackTeamNames(picks []fallbackPick) []string {
+	var names []string
+	seen := make(map[int64]struct{}, len(picks))
+	for _, pk := range picks {
+		if _, ok := seen[pk.team.id]; ok {
+			continue
+		}
+		seen[pk.team.id] = struct{}{}
+		names = append(names, pk.team.name)
+	}
+	return names
+}
+
+func teamNames(teams []teamRef) []string {
+	names := make([]string, 0, len(teams))
+	for _, t := range teams {
+		names = append(names, t.name)
+	}
+	return names
+}
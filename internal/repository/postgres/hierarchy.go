@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// hierarchyLockClass namespaces the advisory lock serializing changes of team parents,
+// so two concurrent moves cannot close a cycle the other one did not see.
+const hierarchyLockClass = 4202
+
+const (
+	// UNION rather than UNION ALL stops the walk should the tree ever contain a cycle.
+	selectSubtreeIDsQuery = `
+WITH RECURSIVE subtree AS (
+    SELECT id FROM teams WHERE id=$1
+    UNION
+    SELECT c.id FROM teams c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT id FROM subtree ORDER BY id`
+	selectDescendantsQuery = `
+WITH RECURSIVE subtree AS (
+    SELECT c.id, c.name, p.name AS parent, 1 AS depth
+    FROM teams c JOIN teams p ON p.id = c.parent_id
+    WHERE p.name=$1
+    UNION ALL
+    SELECT c.id, c.name, s.name, s.depth + 1
+    FROM teams c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT id, name, parent FROM subtree ORDER BY depth, name`
+	selectMembersOfTeamsQuery = `SELECT team_id, id, username, is_active, role FROM users WHERE team_id = ANY($1::bigint[]) ORDER BY id`
+	lockHierarchyQuery        = `SELECT pg_advisory_xact_lock($1, 0)`
+	lockHierarchySharedQuery  = `SELECT pg_advisory_xact_lock_shared($1, 0)`
+	updateTeamParentQuery     = `UPDATE teams SET parent_id=$2 WHERE id=$1`
+)
+
+// SetTeamParent moves a team with its descendants under parent, or to the top level when parent is empty.
+// Moving a team under itself or one of its descendants is rejected.
+func (p *Postgres) SetTeamParent(ctx context.Context, name, parent string) (*entities.Team, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, lockHierarchyQuery, hierarchyLockClass); err != nil {
+		p.log.Errorw("failed to lock team hierarchy", "team", name, "error", err)
+		return nil, fmt.Errorf("lock team hierarchy: %w", err)
+	}
+	teamID, err := p.teamID(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+	var parentID *int64
+	if parent != "" {
+		id, err := p.teamID(ctx, tx, parent)
+		if err != nil {
+			if errors.Is(err, entities.ErrTeamNotFound) {
+				return nil, fmt.Errorf("%w: parent team %s", entities.ErrTeamNotFound, parent)
+			}
+			return nil, err
+		}
+		subtree, err := p.readSubtreeIDs(ctx, tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sid := range subtree {
+			if sid == id {
+				p.log.Errorw("team parent would close a cycle", "team", name, "parent", parent)
+				return nil, fmt.Errorf("%w: %s is %s or one of its descendants", entities.ErrInvalidArgument, parent, name)
+			}
+		}
+		parentID = &id
+	}
+
+	if _, err := tx.Exec(ctx, updateTeamParentQuery, teamID, parentID); err != nil {
+		p.log.Errorw("failed to update team parent", "team", name, "parent", parent, "error", err)
+		return nil, fmt.Errorf("update team parent: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		p.log.Errorw("failed to commit team parent change", "team", name, "parent", parent, "error", err)
+		return nil, err
+	}
+
+	p.log.Infow("team parent changed", "team", name, "parent", parent)
+	return p.GetTeam(ctx, name)
+}
+
+// ListTeamDescendants returns the teams below the named one on all levels, each with its parent
+// and members, level by level and by name within a level.
+func (p *Postgres) ListTeamDescendants(ctx context.Context, name string) ([]entities.Team, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := p.teamID(ctx, tx, name); err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, selectDescendantsQuery, name)
+	if err != nil {
+		p.log.Errorw("failed to select team descendants", "team", name, "error", err)
+		return nil, fmt.Errorf("select team descendants: %w", err)
+	}
+	var ids []int64
+	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.Team, error) {
+		var id int64
+		t := entities.Team{Members: make([]entities.User, 0)}
+		if err := row.Scan(&id, &t.Name, &t.Parent); err != nil {
+			return t, err
+		}
+		ids = append(ids, id)
+		return t, nil
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan team descendants", "team", name, "error", err)
+		return nil, fmt.Errorf("scan team descendants: %w", err)
+	}
+	if len(teams) == 0 {
+		return teams, nil
+	}
+
+	index := make(map[int64]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+	rows, err = tx.Query(ctx, selectMembersOfTeamsQuery, ids)
+	if err != nil {
+		p.log.Errorw("failed to select descendant members", "team", name, "error", err)
+		return nil, fmt.Errorf("select descendant members: %w", err)
+	}
+	_, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (struct{}, error) {
+		var teamID int64
+		var u entities.User
+		if err := row.Scan(&teamID, &u.ID, &u.Username, &u.IsActive, &u.Role); err != nil {
+			return struct{}{}, err
+		}
+		t := &teams[index[teamID]]
+		u.TeamName = t.Name
+		t.Members = append(t.Members, u)
+		return struct{}{}, nil
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan descendant members", "team", name, "error", err)
+		return nil, fmt.Errorf("scan descendant members: %w", err)
+	}
+	return teams, nil
+}
+
+// readSubtreeIDs returns the ids of a team and all teams below it in ascending order.
+func (p *Postgres) readSubtreeIDs(ctx context.Context, q querier, teamID int64) ([]int64, error) {
+	rows, err := q.Query(ctx, selectSubtreeIDsQuery, teamID)
+	if err != nil {
+		p.log.Errorw("failed to select team subtree", "team_id", teamID, "error", err)
+		return nil, fmt.Errorf("select team subtree: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		p.log.Errorw("failed to scan team subtree", "team_id", teamID, "error", err)
+		return nil, fmt.Errorf("scan team subtree: %w", err)
+	}
+	return ids, nil
+}
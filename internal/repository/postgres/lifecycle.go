@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	updatePRReadyQuery  = `UPDATE pull_requests SET status='OPEN', ready_at=NOW() WHERE id=$1`
+	updatePRClosedQuery = `UPDATE pull_requests SET status='CLOSED', closed_at=NOW() WHERE id=$1 RETURNING closed_at`
+	// A closed PR that was never ready for review goes back to draft; a reopened one restarts the review SLA.
+	updatePRReopenedQuery = `
+UPDATE pull_requests
+SET status = CASE WHEN ready_at IS NULL THEN 'DRAFT' ELSE 'OPEN' END,
+    ready_at = CASE WHEN ready_at IS NULL THEN NULL ELSE NOW() END,
+    closed_at = NULL
+WHERE id=$1
+RETURNING status`
+	// Capacity is checked while the PR is still closed, so its own review does not count yet.
+	selectIneligibleReviewersQuery = `
+SELECT u.id FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id
+WHERE r.pr_id=$1 AND NOT (u.is_active AND ` + availableFilter + ` AND ` + capacityFilter + `)
+ORDER BY u.id`
+)
+
+// MarkPRReady turns a draft PR into an open one and assigns its reviewers like CreatePR does.
+// Marking an open PR ready is a no-op.
+func (p *Postgres) MarkPRReady(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	locked, err := p.lockPR(ctx, tx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+	switch locked.Status {
+	case entities.StatusMerged:
+		return nil, entities.ErrPRMerged
+	case entities.StatusClosed:
+		p.log.Errorw("cannot mark a closed PR ready", "pr_id", pr.ID)
+		return nil, fmt.Errorf("%w: %s is %s", entities.ErrInvalidStatus, pr.ID, locked.Status)
+	case entities.StatusDraft:
+		var authorTeamID int64
+		var authorActive bool
+		if err := tx.QueryRow(ctx, shareAuthorQuery, locked.AuthorID).Scan(&authorTeamID, &authorActive); err != nil {
+			p.log.Errorw("failed to query author team", "error", err, "pr_id", pr.ID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("%w: author %s is not a member of any team", entities.ErrInvalidArgument, locked.AuthorID)
+			}
+			return nil, fmt.Errorf("author lookup: %w", err)
+		}
+		if !authorActive {
+			return nil, fmt.Errorf("%w: author inactive", entities.ErrInvalidArgument)
+		}
+		if _, err := tx.Exec(ctx, updatePRReadyQuery, pr.ID); err != nil {
+			p.log.Errorw("failed to mark pr ready", "error", err, "pr_id", pr.ID)
+			return nil, fmt.Errorf("mark pr ready: %w", err)
+		}
+		if locked.Labels, err = p.readPRLabels(ctx, tx, pr.ID); err != nil {
+			return nil, err
+		}
+		locked.CodeOwners = pr.CodeOwners
+		plan, err := p.assignReviewers(ctx, tx, locked, authorTeamID, sel)
+		if err != nil {
+			return nil, err
+		}
+		locked.Status = entities.StatusOpen
+		locked.Reviewers = append(locked.Reviewers, plan.Reviewers...)
+		if err := p.publishReviewersAssigned(ctx, tx, locked, plan.Reviewers); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.completePR(ctx, tx, &locked); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("pr ready for review", "pr_id", pr.ID, "reviewers", locked.Reviewers)
+	return &locked, nil
+}
+
+// ClosePR abandons a draft or open PR idempotently; its reviews stop counting as open.
+// Closing emits pr.closed; closing a closed PR emits nothing.
+func (p *Postgres) ClosePR(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	switch pr.Status {
+	case entities.StatusMerged:
+		return nil, entities.ErrPRMerged
+	case entities.StatusDraft, entities.StatusOpen:
+		var closedAt time.Time
+		if err := tx.QueryRow(ctx, updatePRClosedQuery, prID).Scan(&closedAt); err != nil {
+			p.log.Errorw("failed to close pr", "error", err, "pr_id", prID)
+			return nil, fmt.Errorf("close pr: %w", err)
+		}
+		pr.Status = entities.StatusClosed
+		pr.ClosedAt = &closedAt
+		if err := p.publishPREvent(ctx, tx, entities.EventPRClosed, pr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("pr closed", "pr_id", prID)
+	return &pr, nil
+}
+
+// ReopenPR brings a closed PR back with its former reviewers: to OPEN, or to DRAFT when it was
+// closed before ever being ready. Reopening an open PR is a no-op.
+// Former reviewers who became inactive, unavailable or full while the PR was closed are replaced
+// through sel; one without a replacement stays assigned.
+func (p *Postgres) ReopenPR(ctx context.Context, prID string, sel entities.ReviewerSelector) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	switch pr.Status {
+	case entities.StatusMerged:
+		return nil, entities.ErrPRMerged
+	case entities.StatusDraft:
+		p.log.Errorw("cannot reopen a draft PR", "pr_id", prID)
+		return nil, fmt.Errorf("%w: %s is %s", entities.ErrInvalidStatus, prID, pr.Status)
+	case entities.StatusClosed:
+		if err := tx.QueryRow(ctx, shareAuthorQuery, pr.AuthorID).Scan(new(int64), new(bool)); err != nil {
+			p.log.Errorw("failed to query author team", "error", err, "pr_id", prID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("%w: author %s is not a member of any team", entities.ErrInvalidArgument, pr.AuthorID)
+			}
+			return nil, fmt.Errorf("author lookup: %w", err)
+		}
+		rows, err := tx.Query(ctx, selectIneligibleReviewersQuery, prID)
+		if err != nil {
+			p.log.Errorw("failed to select ineligible reviewers", "error", err, "pr_id", prID)
+			return nil, fmt.Errorf("select ineligible reviewers: %w", err)
+		}
+		stale, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			p.log.Errorw("failed to scan ineligible reviewers", "error", err, "pr_id", prID)
+			return nil, fmt.Errorf("scan ineligible reviewers: %w", err)
+		}
+		if err := tx.QueryRow(ctx, updatePRReopenedQuery, prID).Scan(&pr.Status); err != nil {
+			p.log.Errorw("failed to reopen pr", "error", err, "pr_id", prID)
+			return nil, fmt.Errorf("reopen pr: %w", err)
+		}
+		pr.ClosedAt = nil
+		if pr.Status == entities.StatusOpen {
+			for _, r := range stale {
+				res, _, err := p.replaceReviewer(ctx, tx, pr, r, sel, entities.ReassignReopenedIneligible)
+				switch {
+				case err == nil:
+					pr = *res
+				case errors.Is(err, entities.ErrNoCandidate) || errors.Is(err, entities.ErrPairingViolation) ||
+					errors.Is(err, entities.ErrSeniorRequired) || errors.Is(err, entities.ErrUserNotFound):
+					// replaceReviewer fails before writing anything, so the reviewer simply stays.
+					p.log.Warnw("ineligible reviewer left assigned on reopen", "pr_id", prID, "reviewer", r, "reason", err)
+				default:
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("pr reopened", "pr_id", prID, "status", pr.Status)
+	return &pr, nil
+}
+
+// lockPR locks the PR row and loads it with its reviewers.
+func (p *Postgres) lockPR(ctx context.Context, tx pgx.Tx, prID string) (entities.PullRequest, error) {
+	return p.readPR(ctx, tx, selectPRForUpdateQuery, prID)
+}
+
+// readPR loads the PR with its reviewers using query, selectPRQuery or its locking variant.
+func (p *Postgres) readPR(ctx context.Context, tx pgx.Tx, query, prID string) (entities.PullRequest, error) {
+	var pr entities.PullRequest
+	var createdAt time.Time
+	if err := tx.QueryRow(ctx, query, prID).
+		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &createdAt, &pr.MergedAt, &pr.ClosedAt); err != nil {
+		p.log.Errorw("failed to select pr", "error", err, "pr_id", prID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pr, entities.ErrPRNotFound
+		}
+		return pr, fmt.Errorf("get pr: %w", err)
+	}
+	pr.CreatedAt = &createdAt
+
+	reviewers, err := p.readReviewers(ctx, tx, prID)
+	if err != nil {
+		return pr, err
+	}
+	pr.Reviewers = reviewers
+	return pr, nil
+}
+
+// assignReviewers plans the reviewers of a PR that became open and stores them.
+func (p *Postgres) assignReviewers(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, authorTeamID int64, sel entities.ReviewerSelector) (entities.AssignmentPreview, error) {
+	plan, picks, err := p.planAssignment(ctx, tx, pr, authorTeamID, sel, false)
+	if err != nil {
+		return plan, err
+	}
+	fallbackOf := make(map[string]int64, len(picks))
+	for _, pk := range picks {
+		fallbackOf[pk.userID] = pk.team.id
+	}
+	for _, r := range plan.Reviewers {
+		var fallbackTeamID *int64
+		if id, ok := fallbackOf[r]; ok {
+			fallbackTeamID = &id
+		}
+		if _, err := tx.Exec(ctx, insertReviewerQuery, pr.ID, r, fallbackTeamID); err != nil {
+			p.log.Errorw("failed to insert reviewer", "error", err, "reviewer_id", r)
+			return plan, fmt.Errorf("insert reviewer: %w", err)
+		}
+	}
+	return plan, nil
+}
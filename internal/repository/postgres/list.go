@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	listPRsQuery = `SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.closed_at,
+    ARRAY(SELECT r.reviewer_id FROM pr_reviewers r WHERE r.pr_id = pr.id ORDER BY r.reviewer_id),
+    ARRAY(SELECT l.label FROM pr_labels l WHERE l.pr_id = pr.id ORDER BY l.label)
+FROM pull_requests pr`
+	listUsersQuery = `SELECT ` + userColumns + `
+FROM users u
+LEFT JOIN teams t ON t.id = u.team_id`
+	listTeamsQuery = `SELECT t.name, COALESCE(parent.name, ''), COUNT(u.id), COUNT(u.id) FILTER (WHERE u.is_active)
+FROM teams t
+LEFT JOIN teams parent ON parent.id = t.parent_id
+LEFT JOIN users u ON u.team_id = t.id`
+)
+
+// GetPR loads a PR with its reviewers, reviews, labels and fallback teams.
+func (p *Postgres) GetPR(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.readPR(ctx, tx, selectPRQuery, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// ListPRs returns up to filter.Limit PRs matching filter, newest first, with their reviewers and labels.
+func (p *Postgres) ListPRs(ctx context.Context, filter entities.PullRequestFilter) ([]entities.PullRequest, error) {
+	var w whereBuilder
+	if filter.Status != nil {
+		w.add("pr.status = $%", *filter.Status)
+	}
+	if filter.AuthorID != "" {
+		w.add("pr.author_id = $%", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		w.add("EXISTS (SELECT 1 FROM pr_reviewers r WHERE r.pr_id = pr.id AND r.reviewer_id = $%)", filter.ReviewerID)
+	}
+	if filter.TeamName != "" {
+		w.add("pr.author_id IN (SELECT u.id FROM users u JOIN teams t ON t.id = u.team_id WHERE t.name = $%)", filter.TeamName)
+	}
+	if filter.CreatedFrom != nil {
+		w.add("pr.created_at >= $%", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		w.add("pr.created_at < $%", *filter.CreatedTo)
+	}
+	if filter.After != nil {
+		w.add("(pr.created_at, pr.id) < ($%, $%)", filter.After.CreatedAt, filter.After.ID)
+	}
+	query, args := w.build(listPRsQuery, "pr.created_at DESC, pr.id DESC", filter.Limit)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		p.log.Errorw("failed to select prs", "error", err)
+		return nil, fmt.Errorf("select prs: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.PullRequest, error) {
+		var pr entities.PullRequest
+		err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.ClosedAt,
+			&pr.Reviewers, &pr.Labels)
+		return pr, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan prs", "error", err)
+		return nil, fmt.Errorf("scan prs: %w", err)
+	}
+	return res, nil
+}
+
+// ListUsers returns up to filter.Limit users matching filter ordered by ID.
+func (p *Postgres) ListUsers(ctx context.Context, filter entities.UserFilter) ([]entities.User, error) {
+	var w whereBuilder
+	if filter.TeamName != "" {
+		w.add("t.name = $%", filter.TeamName)
+	}
+	if filter.IsActive != nil {
+		w.add("u.is_active = $%", *filter.IsActive)
+	}
+	if filter.Query != "" {
+		pattern := containsPattern(filter.Query)
+		w.add("(u.id ILIKE $% OR u.username ILIKE $%)", pattern, pattern)
+	}
+	if filter.AfterID != "" {
+		w.add("u.id > $%", filter.AfterID)
+	}
+	query, args := w.build(listUsersQuery, "u.id", filter.Limit)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		p.log.Errorw("failed to select users", "error", err)
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.User, error) {
+		u, err := scanUser(row)
+		if err != nil {
+			return entities.User{}, err
+		}
+		return *u, nil
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan users", "error", err)
+		return nil, fmt.Errorf("scan users: %w", err)
+	}
+	return res, nil
+}
+
+// ListTeams returns up to filter.Limit teams matching filter ordered by name, with their parents and member counts.
+func (p *Postgres) ListTeams(ctx context.Context, filter entities.TeamFilter) ([]entities.TeamSummary, error) {
+	var w whereBuilder
+	if filter.Query != "" {
+		w.add("t.name ILIKE $%", containsPattern(filter.Query))
+	}
+	if filter.AfterName != "" {
+		w.add("t.name > $%", filter.AfterName)
+	}
+	w.groupBy = "t.id, t.name, parent.name"
+	query, args := w.build(listTeamsQuery, "t.name", filter.Limit)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		p.log.Errorw("failed to select teams", "error", err)
+		return nil, fmt.Errorf("select teams: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.TeamSummary, error) {
+		var t entities.TeamSummary
+		err := row.Scan(&t.Name, &t.Parent, &t.Members, &t.ActiveMembers)
+		return t, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan teams", "error", err)
+		return nil, fmt.Errorf("scan teams: %w", err)
+	}
+	return res, nil
+}
+
+// whereBuilder collects listing conditions and their arguments.
+type whereBuilder struct {
+	conditions []string
+	args       []any
+	groupBy    string
+}
+
+// add appends a condition; every "$%" in it is numbered after the arguments collected so far,
+// one per value.
+func (w *whereBuilder) add(condition string, values ...any) {
+	for _, v := range values {
+		w.args = append(w.args, v)
+		condition = strings.Replace(condition, "$%", "$"+strconv.Itoa(len(w.args)), 1)
+	}
+	w.conditions = append(w.conditions, condition)
+}
+
+// build appends the conditions, ordering and limit to base.
+func (w *whereBuilder) build(base, orderBy string, limit int) (string, []any) {
+	var b strings.Builder
+	b.WriteString(base)
+	if len(w.conditions) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(w.conditions, " AND "))
+	}
+	if w.groupBy != "" {
+		b.WriteString(" GROUP BY " + w.groupBy)
+	}
+	args := append(w.args, limit)
+	b.WriteString(" ORDER BY " + orderBy + " LIMIT $" + strconv.Itoa(len(args)))
+	return b.String(), args
+}
+
+// containsPattern builds an ILIKE pattern matching s anywhere, with s's wildcards escaped.
+func containsPattern(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + r.Replace(s) + "%"
+}
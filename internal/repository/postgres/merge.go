@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	selectInactiveReviewersQuery = `
+SELECT u.id
+FROM pr_reviewers r
+JOIN users u ON u.id = r.reviewer_id
+WHERE r.pr_id=$1 AND u.is_active=false
+ORDER BY u.id`
+	prYoungerThanQuery = `SELECT created_at > NOW() - make_interval(secs => $2) FROM pull_requests WHERE id=$1`
+)
+
+// checkMergePolicy fails with ErrMergeBlocked listing every precondition of the author team's
+// merge policy that pr does not meet.
+func (p *Postgres) checkMergePolicy(ctx context.Context, tx pgx.Tx, pr entities.PullRequest) error {
+	var authorTeamID int64
+	if err := tx.QueryRow(ctx, selectAuthorQuery, pr.AuthorID).Scan(&authorTeamID, new(bool)); err != nil {
+		p.log.Errorw("failed to query author team", "error", err, "pr_id", pr.ID)
+		return fmt.Errorf("author lookup: %w", err)
+	}
+	policy, err := p.readTeamPolicy(ctx, tx, authorTeamID, "")
+	if err != nil {
+		return err
+	}
+	merge := policy.Merge
+
+	var violations []string
+	if n := len(pr.Reviewers); n < merge.MinReviewers {
+		violations = append(violations, fmt.Sprintf("%d of %d required reviewers assigned", n, merge.MinReviewers))
+	}
+	if merge.RequireActiveReviewers {
+		rows, err := tx.Query(ctx, selectInactiveReviewersQuery, pr.ID)
+		if err != nil {
+			p.log.Errorw("failed to select inactive reviewers", "error", err, "pr_id", pr.ID)
+			return fmt.Errorf("select inactive reviewers: %w", err)
+		}
+		inactive, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			p.log.Errorw("failed to scan inactive reviewers", "error", err, "pr_id", pr.ID)
+			return fmt.Errorf("scan inactive reviewers: %w", err)
+		}
+		if len(inactive) > 0 {
+			violations = append(violations, "inactive reviewers "+strings.Join(inactive, ", "))
+		}
+	}
+	if merge.MinAge > 0 {
+		var young bool
+		if err := tx.QueryRow(ctx, prYoungerThanQuery, pr.ID, merge.MinAge.Seconds()).Scan(&young); err != nil {
+			p.log.Errorw("failed to check pr age", "error", err, "pr_id", pr.ID)
+			return fmt.Errorf("check pr age: %w", err)
+		}
+		if young {
+			violations = append(violations, fmt.Sprintf("PR is younger than %s", merge.MinAge))
+		}
+	}
+
+	if len(violations) > 0 {
+		p.log.Errorw("merge blocked by team policy", "pr_id", pr.ID, "violations", violations)
+		return fmt.Errorf("%w: %s", entities.ErrMergeBlocked, strings.Join(violations, "; "))
+	}
+	return nil
+}
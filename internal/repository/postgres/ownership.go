@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	selectOwnershipRulesQuery = `
+SELECT r.id, r.pattern, o.owner_kind, o.owner_ref
+FROM ownership_rules r
+LEFT JOIN ownership_rule_owners o ON o.rule_id = r.id
+ORDER BY r.position, o.owner_kind, o.owner_ref`
+	deleteOwnershipRulesQuery  = `DELETE FROM ownership_rules`
+	insertOwnershipRuleQuery   = `INSERT INTO ownership_rules(position, pattern) VALUES ($1, $2) RETURNING id`
+	insertRuleOwnerQuery       = `INSERT INTO ownership_rule_owners(rule_id, owner_kind, owner_ref) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	userExistsQuery            = `SELECT EXISTS (SELECT 1 FROM users WHERE id=$1)`
+	teamExistsQuery            = `SELECT EXISTS (SELECT 1 FROM teams WHERE name=$1)`
+	selectOwnerCandidatesQuery = `SELECT ` + candidateColumns + `
+FROM users u
+JOIN teams t ON t.id = u.team_id
+WHERE u.is_active=true AND u.id <> $1
+  AND (u.id = ANY($2::text[]) OR t.name = ANY($3::text[]))
+  AND NOT ($4::boolean AND u.team_id = $5)
+  AND ` + availableFilter + ` AND ` + capacityFilter
+	selectOwnerTeamIDsQuery = `
+SELECT DISTINCT u.team_id
+FROM users u
+JOIN teams t ON t.id = u.team_id
+WHERE u.id = ANY($1::text[]) OR t.name = ANY($2::text[])
+ORDER BY u.team_id`
+)
+
+// ListOwnershipRules returns ownership rules in evaluation order.
+func (p *Postgres) ListOwnershipRules(ctx context.Context) ([]entities.OwnershipRule, error) {
+	rows, err := p.db.Query(ctx, selectOwnershipRulesQuery)
+	if err != nil {
+		p.log.Errorw("failed to select ownership rules", "error", err)
+		return nil, fmt.Errorf("select ownership rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]entities.OwnershipRule, 0)
+	lastID := int64(-1)
+	for rows.Next() {
+		var id int64
+		var pattern string
+		var kind, ref *string
+		if err := rows.Scan(&id, &pattern, &kind, &ref); err != nil {
+			p.log.Errorw("failed to scan ownership rule", "error", err)
+			return nil, fmt.Errorf("scan ownership rule: %w", err)
+		}
+		if id != lastID {
+			rules = append(rules, entities.OwnershipRule{Pattern: pattern, Owners: make([]entities.Owner, 0)})
+			lastID = id
+		}
+		if kind != nil && ref != nil {
+			last := &rules[len(rules)-1]
+			last.Owners = append(last.Owners, entities.Owner{Kind: entities.OwnerKind(*kind), ID: *ref})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating ownership rules", "error", err)
+		return nil, fmt.Errorf("iterate ownership rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ReplaceOwnershipRules atomically replaces the whole ownership rule set.
+func (p *Postgres) ReplaceOwnershipRules(ctx context.Context, rules []entities.OwnershipRule) ([]entities.OwnershipRule, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, deleteOwnershipRulesQuery); err != nil {
+		p.log.Errorw("failed to delete ownership rules", "error", err)
+		return nil, fmt.Errorf("delete ownership rules: %w", err)
+	}
+
+	for i, r := range rules {
+		var ruleID int64
+		if err := tx.QueryRow(ctx, insertOwnershipRuleQuery, i, r.Pattern).Scan(&ruleID); err != nil {
+			p.log.Errorw("failed to insert ownership rule", "pattern", r.Pattern, "error", err)
+			return nil, fmt.Errorf("insert ownership rule: %w", err)
+		}
+		for _, o := range r.Owners {
+			if err := p.ensureOwnerExists(ctx, tx, o); err != nil {
+				return nil, err
+			}
+			if _, err := tx.Exec(ctx, insertRuleOwnerQuery, ruleID, o.Kind, o.ID); err != nil {
+				p.log.Errorw("failed to insert rule owner", "pattern", r.Pattern, "owner", o.ID, "error", err)
+				return nil, fmt.Errorf("insert rule owner: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		p.log.Errorw("failed to commit ownership rules", "error", err)
+		return nil, err
+	}
+
+	p.log.Infow("ownership rules replaced", "rules", len(rules))
+	return p.ListOwnershipRules(ctx)
+}
+
+func (p *Postgres) ensureOwnerExists(ctx context.Context, tx pgx.Tx, o entities.Owner) error {
+	query, notFound := userExistsQuery, entities.ErrUserNotFound
+	if o.Kind == entities.OwnerTeam {
+		query, notFound = teamExistsQuery, entities.ErrTeamNotFound
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, query, o.ID).Scan(&exists); err != nil {
+		p.log.Errorw("failed to check owner", "kind", o.Kind, "owner", o.ID, "error", err)
+		return fmt.Errorf("check owner: %w", err)
+	}
+	if !exists {
+		p.log.Errorw("owner not found", "kind", o.Kind, "owner", o.ID)
+		return fmt.Errorf("%w: %s %s", notFound, o.Kind, o.ID)
+	}
+	return nil
+}
+
+// readOwnerCandidates loads active code owners eligible to review a PR of authorID.
+func (p *Postgres) readOwnerCandidates(ctx context.Context, tx pgx.Tx, owners []entities.Owner, policy entities.TeamPolicy, authorTeamID int64, authorID string, exclude map[string]struct{}) ([]entities.Candidate, error) {
+	if len(owners) == 0 {
+		return nil, nil
+	}
+	userIDs, teamNames := splitOwners(owners)
+	return p.readCandidates(ctx, tx, selectOwnerCandidatesQuery, exclude, authorID, userIDs, teamNames, policy.ExcludeAuthorTeam, authorTeamID)
+}
+
+// readOwnerTeamIDs returns the ids of the teams code owners belong to, in ascending order.
+func (p *Postgres) readOwnerTeamIDs(ctx context.Context, tx pgx.Tx, owners []entities.Owner) ([]int64, error) {
+	if len(owners) == 0 {
+		return nil, nil
+	}
+	userIDs, teamNames := splitOwners(owners)
+	rows, err := tx.Query(ctx, selectOwnerTeamIDsQuery, userIDs, teamNames)
+	if err != nil {
+		p.log.Errorw("failed to select owner teams", "error", err)
+		return nil, fmt.Errorf("select owner teams: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		p.log.Errorw("failed to read owner teams", "error", err)
+		return nil, fmt.Errorf("read owner teams: %w", err)
+	}
+	return ids, nil
+}
+
+// splitOwners separates user owners from team owners.
+func splitOwners(owners []entities.Owner) (userIDs, teamNames []string) {
+	userIDs = make([]string, 0, len(owners))
+	teamNames = make([]string, 0, len(owners))
+	for _, o := range owners {
+		switch o.Kind {
+		case entities.OwnerUser:
+			userIDs = append(userIDs, o.ID)
+		case entities.OwnerTeam:
+			teamNames = append(teamNames, o.ID)
+		}
+	}
+	return userIDs, teamNames
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pairingColumns         = `id, kind, author_id, reviewer_id`
+	insertPairingRuleQuery = `
+INSERT INTO pairing_rules(kind, author_id, reviewer_id)
+VALUES ($1, $2, $3)
+RETURNING ` + pairingColumns
+	selectPairingRulesQuery = `SELECT ` + pairingColumns + `
+FROM pairing_rules
+WHERE $1 = '' OR author_id = $1 OR reviewer_id = $1
+ORDER BY id`
+	deletePairingRuleQuery        = `DELETE FROM pairing_rules WHERE id = $1 RETURNING ` + pairingColumns
+	selectAuthorPairingQuery      = `SELECT kind, reviewer_id FROM pairing_rules WHERE author_id = $1 ORDER BY reviewer_id`
+	selectRequiredCandidatesQuery = `SELECT ` + candidateColumns + `
+FROM users u
+WHERE u.id = ANY($1) AND u.is_active=true AND ` + availableFilter + `
+ORDER BY u.id`
+)
+
+// AddPairingRule stores a blocked or required author/reviewer pair.
+func (p *Postgres) AddPairingRule(ctx context.Context, rule entities.PairingRule) (*entities.PairingRule, error) {
+	if err := p.ensureUserExists(ctx, rule.AuthorID); err != nil {
+		return nil, err
+	}
+	if err := p.ensureUserExists(ctx, rule.ReviewerID); err != nil {
+		return nil, err
+	}
+	res, err := scanPairingRule(p.db.QueryRow(ctx, insertPairingRuleQuery, rule.Kind, rule.AuthorID, rule.ReviewerID))
+	if err != nil {
+		p.log.Errorw("failed to insert pairing rule", "error", err, "author_id", rule.AuthorID, "reviewer_id", rule.ReviewerID)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, entities.ErrPairingRuleExists
+		}
+		return nil, fmt.Errorf("insert pairing rule: %w", err)
+	}
+
+	p.log.Infow("pairing rule added", "id", res.ID, "kind", res.Kind, "author_id", res.AuthorID, "reviewer_id", res.ReviewerID)
+	return res, nil
+}
+
+// ListPairingRules returns pairing rules mentioning userID as author or reviewer, or all rules when userID is empty.
+func (p *Postgres) ListPairingRules(ctx context.Context, userID string) ([]entities.PairingRule, error) {
+	rows, err := p.db.Query(ctx, selectPairingRulesQuery, userID)
+	if err != nil {
+		p.log.Errorw("failed to select pairing rules", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("select pairing rules: %w", err)
+	}
+	defer rows.Close()
+	res := make([]entities.PairingRule, 0)
+	for rows.Next() {
+		rule, err := scanPairingRule(rows)
+		if err != nil {
+			p.log.Errorw("failed to scan pairing rule", "error", err)
+			return nil, fmt.Errorf("scan pairing rule: %w", err)
+		}
+		res = append(res, *rule)
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating pairing rules", "error", err)
+		return nil, fmt.Errorf("iterate pairing rules: %w", err)
+	}
+	return res, nil
+}
+
+// DeletePairingRule removes a pairing rule and returns it.
+func (p *Postgres) DeletePairingRule(ctx context.Context, id int64) (*entities.PairingRule, error) {
+	res, err := scanPairingRule(p.db.QueryRow(ctx, deletePairingRuleQuery, id))
+	if err != nil {
+		p.log.Errorw("failed to delete pairing rule", "error", err, "id", id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrPairingRuleNotFound
+		}
+		return nil, fmt.Errorf("delete pairing rule: %w", err)
+	}
+
+	p.log.Infow("pairing rule deleted", "id", id, "author_id", res.AuthorID, "reviewer_id", res.ReviewerID)
+	return res, nil
+}
+
+// authorPairing holds the pairing rules of one author.
+type authorPairing struct {
+	blocked  map[string]struct{}
+	required []string
+}
+
+// requires reports whether userID must review every PR of the author.
+func (a authorPairing) requires(userID string) bool {
+	for _, id := range a.required {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// exclude returns ids the selector must not pick: blocked reviewers and required ones placed up front.
+func (a authorPairing) exclude() map[string]struct{} {
+	res := make(map[string]struct{}, len(a.blocked)+len(a.required))
+	for id := range a.blocked {
+		res[id] = struct{}{}
+	}
+	for _, id := range a.required {
+		res[id] = struct{}{}
+	}
+	return res
+}
+
+func (p *Postgres) readAuthorPairing(ctx context.Context, tx pgx.Tx, authorID string) (authorPairing, error) {
+	res := authorPairing{blocked: make(map[string]struct{})}
+	rows, err := tx.Query(ctx, selectAuthorPairingQuery, authorID)
+	if err != nil {
+		p.log.Errorw("failed to select author pairing rules", "error", err, "author_id", authorID)
+		return res, fmt.Errorf("select author pairing rules: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var kind entities.PairingKind
+		var reviewerID string
+		if err := rows.Scan(&kind, &reviewerID); err != nil {
+			p.log.Errorw("failed to scan author pairing rule", "error", err, "author_id", authorID)
+			return res, fmt.Errorf("scan author pairing rule: %w", err)
+		}
+		switch kind {
+		case entities.PairingBlock:
+			res.blocked[reviewerID] = struct{}{}
+		case entities.PairingRequire:
+			res.required = append(res.required, reviewerID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating author pairing rules", "error", err, "author_id", authorID)
+		return res, fmt.Errorf("iterate author pairing rules: %w", err)
+	}
+	return res, nil
+}
+
+// readRequiredCandidates loads required reviewers and fails if any of them cannot review right now.
+func (p *Postgres) readRequiredCandidates(ctx context.Context, tx pgx.Tx, ids []string) ([]entities.Candidate, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	candidates, err := p.readCandidates(ctx, tx, selectRequiredCandidatesQuery, nil, ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		found[c.UserID] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			return nil, fmt.Errorf("%w: required reviewer %s is inactive or unavailable", entities.ErrPairingViolation, id)
+		}
+	}
+	return candidates, nil
+}
+
+func scanPairingRule(row pgx.Row) (*entities.PairingRule, error) {
+	var r entities.PairingRule
+	if err := row.Scan(&r.ID, &r.Kind, &r.AuthorID, &r.ReviewerID); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -3,13 +3,20 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"assigning-reviewers-for-pr/config"
 	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/usecase/selector"
+	"assigning-reviewers-for-pr/internal/usecase/webhook"
 
 	_ "github.com/lib/pq"
 	"github.com/ory/dockertest/v3"
@@ -43,20 +50,20 @@ func TestRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, team.Name, fetched.Name)
 
-	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"})
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
 	require.Equal(t, entities.StatusOpen, pr.Status)
 	require.Len(t, pr.Reviewers, 2)
 	require.NotContains(t, pr.Reviewers, "u1")
 
 	old := pr.Reviewers[0]
-	reassigned, repl, err := repo.ReassignReviewer(ctx, pr.ID, old)
+	reassigned, repl, err := repo.ReassignReviewer(ctx, pr.ID, old, selector.NewRandom())
 	require.NoError(t, err)
 	require.NotEqual(t, old, repl)
 	require.Contains(t, reassigned.Reviewers, repl)
 	require.NotContains(t, reassigned.Reviewers, old)
 
-	prs, err := repo.GetUserReviews(ctx, repl)
+	prs, err := repo.GetUserReviews(ctx, repl, "", "")
 	require.NoError(t, err)
 	require.NotEmpty(t, prs)
 
@@ -69,7 +76,7 @@ func TestRepositoryIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, merged.MergedAt, merged2.MergedAt)
 
-	_, _, err = repo.ReassignReviewer(ctx, pr.ID, repl)
+	_, _, err = repo.ReassignReviewer(ctx, pr.ID, repl, selector.NewRandom())
 	require.ErrorIs(t, err, entities.ErrPRMerged)
 
 	prStats, err := repo.PRStats(ctx, pr.ID)
@@ -173,9 +180,9 @@ func TestRepositoryStatsIntegration(t *testing.T) {
 	_, err := repo.CreateTeam(ctx, team)
 	require.NoError(t, err)
 
-	pr1, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"})
+	pr1, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
-	pr2, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr2", Name: "Feature", AuthorID: "u1"})
+	pr2, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr2", Name: "Feature", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
 
 	stats, err := repo.Stats(ctx)
@@ -229,9 +236,9 @@ func TestRepositoryStatsSummary(t *testing.T) {
 	_, err := repo.CreateTeam(ctx, team)
 	require.NoError(t, err)
 
-	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"})
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr1", Name: "Init", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
-	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr2", Name: "Feature", AuthorID: "u1"})
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr2", Name: "Feature", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
 
 	summary, err := repo.StatsSummary(ctx, entities.StatsFilter{Limit: 5})
@@ -266,7 +273,7 @@ func TestMergeIdempotentIntegration(t *testing.T) {
 	_, err := repo.CreateTeam(ctx, team)
 	require.NoError(t, err)
 
-	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-merge", Name: "Merge", AuthorID: "u1"})
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-merge", Name: "Merge", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
 	require.Equal(t, entities.StatusOpen, pr.Status)
 
@@ -305,12 +312,12 @@ func TestDeactivateTeamReassignIntegration(t *testing.T) {
 	_, err = repo.CreateTeam(ctx, teamB)
 	require.NoError(t, err)
 
-	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-deact", Name: "Test", AuthorID: "u1"})
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-deact", Name: "Test", AuthorID: "u1"}, selector.NewRandom())
 	require.NoError(t, err)
 	initialCount := len(pr.Reviewers)
 	require.NotZero(t, initialCount)
 
-	result, err := repo.DeactivateTeam(ctx, "backend")
+	result, err := repo.DeactivateTeam(ctx, "backend", false, selector.NewRandom())
 	require.NoError(t, err)
 	require.Equal(t, 2, result.DeactivatedUsers)
 	require.Equal(t, initialCount, result.Reassigned+result.Removed)
@@ -322,3 +329,1697 @@ func TestDeactivateTeamReassignIntegration(t *testing.T) {
 	}
 	require.Len(t, updated.Reviewers, initialCount-result.Removed)
 }
+
+func TestDeactivateTeamLeastOpenIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dana", IsActive: true},
+		{ID: "u5", Username: "Eve", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "qa", Members: []entities.User{
+		{ID: "q1", Username: "Quinn", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1, SelectionMode: entities.SelectionLeastOpen})
+	require.NoError(t, err)
+
+	// u3 and u5 already review one open PR each, u4 none.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-qa", Name: "Busy", AuthorID: "q1"}, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.AddReviewer(ctx, "pr-qa", "u3")
+	require.NoError(t, err)
+	_, err = repo.AddReviewer(ctx, "pr-qa", "u5")
+	require.NoError(t, err)
+	_, err = repo.SetUserActive(ctx, "q1", false)
+	require.NoError(t, err)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.Reviewers)
+
+	// The service default is random; the author team policy asks for least_open.
+	sel, err := selector.NewRegistry(entities.SelectionRandom)
+	require.NoError(t, err)
+	result, err := repo.DeactivateTeam(ctx, "backend", false, sel)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Reassigned)
+
+	stats, err := repo.PRStats(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u4"}, stats.Reviewers)
+}
+
+func TestLeastOpenConcurrentCreateIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	team := entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}}
+	_, err := repo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	sel := selector.NewLeastOpen()
+	const prCount = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, prCount)
+	for i := 0; i < prCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr" + strconv.Itoa(i), Name: "Load", AuthorID: "author"}, sel)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	for _, id := range []string{"u1", "u2", "u3", "u4"} {
+		stats, err := repo.ReviewerStats(ctx, id, 1)
+		require.NoError(t, err)
+		require.Equal(t, int64(prCount*2/4), stats.OpenPRCnt, "reviewer %s", id)
+	}
+}
+
+func TestMemberMoveConcurrentCreateIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "f1", Username: "Frank", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	// Moving reviewers out of the team while PRs are assigned in it must not deadlock.
+	const prCount = 8
+	movers := []string{"u1", "u2", "u3", "u4"}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	errs := make(chan error, prCount+len(movers))
+	for i := 0; i < prCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr" + strconv.Itoa(i), Name: "Load", AuthorID: "author"}, selector.NewRandom())
+			errs <- err
+		}(i)
+	}
+	for _, id := range movers {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			<-start
+			_, err := repo.AddTeamMember(ctx, "frontend", entities.User{ID: id, Username: id, IsActive: true}, true, selector.NewRandom())
+			errs <- err
+		}(id)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	team, err := repo.GetTeam(ctx, "frontend")
+	require.NoError(t, err)
+	require.Len(t, team.Members, 1+len(movers))
+}
+
+func TestTeamPolicyIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "platform", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u5", Username: "Eve", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	policy, err := repo.GetTeamPolicy(ctx, "platform")
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultTeamPolicy("platform"), *policy)
+
+	_, err = repo.GetTeamPolicy(ctx, "missing")
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "platform", ReviewerCount: 3, SelectionMode: entities.SelectionLeastOpen})
+	require.NoError(t, err)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-three", Name: "Three", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u3", "u4"}, pr.Reviewers)
+
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "platform", ReviewerCount: 1, ExcludeAuthorTeam: true})
+	require.NoError(t, err)
+
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-cross", Name: "Cross", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u5"}, pr.Reviewers)
+
+	_, _, err = repo.ReassignReviewer(ctx, "pr-three", "u2", selector.NewRandom())
+	require.NoError(t, err)
+	updated, err := repo.PRStats(ctx, "pr-three")
+	require.NoError(t, err)
+	require.Contains(t, updated.Reviewers, "u5")
+}
+
+func TestOwnershipRulesIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "dba", Members: []entities.User{
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	_, err = repo.ReplaceOwnershipRules(ctx, []entities.OwnershipRule{
+		{Pattern: "/db/", Owners: []entities.Owner{{Kind: entities.OwnerUser, ID: "missing"}}},
+	})
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	rules, err := repo.ReplaceOwnershipRules(ctx, []entities.OwnershipRule{
+		{Pattern: "*", Owners: []entities.Owner{{Kind: entities.OwnerTeam, ID: "backend"}}},
+		{Pattern: "/db/", Owners: []entities.Owner{{Kind: entities.OwnerTeam, ID: "dba"}, {Kind: entities.OwnerUser, ID: "u3"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, "/db/", rules[1].Pattern)
+	require.Len(t, rules[1].Owners, 2)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{
+		ID: "pr-db", Name: "Migration", AuthorID: "u1",
+		CodeOwners: []entities.Owner{{Kind: entities.OwnerTeam, ID: "dba"}, {Kind: entities.OwnerUser, ID: "u3"}},
+	}, selector.NewRandom())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u3", "u4"}, pr.Reviewers)
+}
+
+func TestSkillsAndLabelsIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	_, err = repo.SetUserSkills(ctx, "missing", []string{"go"})
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	usr, err := repo.SetUserSkills(ctx, "u4", []string{"go", "sql"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "sql"}, usr.Skills)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-sql", Name: "Query", AuthorID: "u1", Labels: []string{"sql"}}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Contains(t, pr.Reviewers, "u4")
+
+	prs, err := repo.GetUserReviews(ctx, "u4", "sql", "")
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	prs, err = repo.GetUserReviews(ctx, "u4", "frontend", "")
+	require.NoError(t, err)
+	require.Empty(t, prs)
+
+	other := filterOut(pr.Reviewers, "u4")[0]
+	reassigned, _, err := repo.ReassignReviewer(ctx, pr.ID, "u4", selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"sql"}, reassigned.Labels)
+	require.Contains(t, reassigned.Reviewers, other)
+}
+
+func TestUnavailabilityIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	_, err = repo.AddUnavailability(ctx, entities.Unavailability{UserID: "missing", StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour)})
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-before", Name: "Before", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+
+	period, err := repo.AddUnavailability(ctx, entities.Unavailability{
+		UserID: "u2", StartsAt: time.Now().Add(-time.Minute), EndsAt: time.Now().Add(time.Hour), ReassignReviews: true,
+	})
+	require.NoError(t, err)
+	_, err = repo.AddUnavailability(ctx, entities.Unavailability{
+		UserID: "u3", StartsAt: time.Now().Add(time.Hour), EndsAt: time.Now().Add(2 * time.Hour), ReassignReviews: true,
+	})
+	require.NoError(t, err)
+
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-during", Name: "During", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3"}, pr.Reviewers)
+
+	claimed, err := repo.ClaimStartedUnavailability(ctx)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, period.ID, claimed[0].ID)
+	claimed, err = repo.ClaimStartedUnavailability(ctx)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+	require.NoError(t, repo.ReleaseUnavailabilityClaim(ctx, period.ID))
+	claimed, err = repo.ClaimStartedUnavailability(ctx)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	periods, err := repo.ListUnavailability(ctx, "u2")
+	require.NoError(t, err)
+	require.Len(t, periods, 1)
+
+	_, err = repo.DeleteUnavailability(ctx, period.ID)
+	require.NoError(t, err)
+	_, err = repo.DeleteUnavailability(ctx, period.ID)
+	require.ErrorIs(t, err, entities.ErrUnavailabilityNotFound)
+}
+
+func TestUserScheduleIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	usr, err := repo.SetUserActive(ctx, "u1", true)
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultWorkSchedule(), *usr.Schedule)
+
+	night := entities.WorkSchedule{TimeZone: "Asia/Tokyo", StartMinute: 22 * 60, EndMinute: 6 * 60, Days: []time.Weekday{time.Sunday, time.Saturday}}
+	usr, err = repo.SetUserSchedule(ctx, "u1", night)
+	require.NoError(t, err)
+	require.Equal(t, night, *usr.Schedule)
+
+	_, err = repo.SetUserSchedule(ctx, "missing", night)
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	stats, err := repo.ReviewerStats(ctx, "u1", 5)
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Tokyo", stats.Schedule.TimeZone)
+}
+
+func TestPreviewAssignmentIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: false},
+		{ID: "u4", Username: "Dave", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.AddUnavailability(ctx, entities.Unavailability{
+		UserID: "u4", StartsAt: time.Now().Add(-time.Minute), EndsAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = repo.PreviewAssignment(ctx, entities.PullRequest{AuthorID: "missing"}, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	preview, err := repo.PreviewAssignment(ctx, entities.PullRequest{AuthorID: "u1"}, selector.NewRoundRobin())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, preview.Reviewers)
+	require.Len(t, preview.Candidates, 1)
+	require.Equal(t, []entities.ExcludedCandidate{
+		{UserID: "u1", Reason: entities.ExcludedAuthor},
+		{UserID: "u3", Reason: entities.ExcludedInactive},
+		{UserID: "u4", Reason: entities.ExcludedUnavailable},
+	}, preview.Excluded)
+
+	reviews, err := repo.GetUserReviews(ctx, "u2", "", "")
+	require.NoError(t, err)
+	require.Empty(t, reviews)
+
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "qa", Members: []entities.User{
+		{ID: "q1", Username: "Quinn", IsActive: false},
+		{ID: "q2", Username: "Quentin", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"qa"}})
+	require.NoError(t, err)
+
+	// A preview must not wait for an assignment holding the team locks.
+	var teamID int64
+	require.NoError(t, repo.db.QueryRow(ctx, selectTeamIDQuery, "backend").Scan(&teamID))
+	tx, err := repo.db.Begin(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	_, err = tx.Exec(ctx, lockTeamAssignmentsQuery, assignmentLockClass, teamID)
+	require.NoError(t, err)
+
+	lockedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	preview, err = repo.PreviewAssignment(lockedCtx, entities.PullRequest{AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2", "q2"}, preview.Reviewers)
+	require.Equal(t, []string{"qa"}, preview.FallbackTeams)
+	require.Equal(t, []entities.ExcludedCandidate{
+		{UserID: "q1", Reason: entities.ExcludedInactive},
+		{UserID: "u1", Reason: entities.ExcludedAuthor},
+		{UserID: "u3", Reason: entities.ExcludedInactive},
+		{UserID: "u4", Reason: entities.ExcludedUnavailable},
+	}, preview.Excluded)
+}
+
+func TestFallbackTeamsIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "mobile", Members: []entities.User{
+		{ID: "m1", Username: "Alice", IsActive: true},
+		{ID: "m2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "empty", Members: []entities.User{
+		{ID: "e1", Username: "Eve", IsActive: false},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "web", Members: []entities.User{
+		{ID: "w1", Username: "Walt", IsActive: true},
+		{ID: "w2", Username: "Wendy", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "mobile", ReviewerCount: 2, FallbackTeams: []string{"missing"}})
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "mobile", ReviewerCount: 2, FallbackTeams: []string{"empty", "web"}})
+	require.NoError(t, err)
+	policy, err := repo.GetTeamPolicy(ctx, "mobile")
+	require.NoError(t, err)
+	require.Equal(t, []string{"empty", "web"}, policy.FallbackTeams)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "Fallback", AuthorID: "m1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 2)
+	require.Equal(t, "m2", pr.Reviewers[0])
+	require.Contains(t, []string{"w1", "w2"}, pr.Reviewers[1])
+	require.Equal(t, []string{"web"}, pr.FallbackTeams)
+
+	pr, repl, err := repo.ReassignReviewer(ctx, "pr-1", "m2", selector.NewRandom())
+	require.NoError(t, err)
+	require.Contains(t, []string{"w1", "w2"}, repl)
+	require.Equal(t, []string{"web"}, pr.FallbackTeams)
+
+	stats, err := repo.PRStats(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, stats.Reassignments, 1)
+	require.NotNil(t, stats.Reassignments[0].FallbackTeam)
+	require.Equal(t, "web", *stats.Reassignments[0].FallbackTeam)
+
+	_, _, err = repo.ReassignReviewer(ctx, "pr-1", repl, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrNoCandidate)
+}
+
+func TestPairingRulesIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dave", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "mentors", Members: []entities.User{
+		{ID: "m1", Username: "Mentor", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	_, err = repo.AddPairingRule(ctx, entities.PairingRule{Kind: entities.PairingBlock, AuthorID: "u1", ReviewerID: "missing"})
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+	blocked, err := repo.AddPairingRule(ctx, entities.PairingRule{Kind: entities.PairingBlock, AuthorID: "u1", ReviewerID: "u2"})
+	require.NoError(t, err)
+	_, err = repo.AddPairingRule(ctx, entities.PairingRule{Kind: entities.PairingRequire, AuthorID: "u1", ReviewerID: "u2"})
+	require.ErrorIs(t, err, entities.ErrPairingRuleExists)
+	_, err = repo.AddPairingRule(ctx, entities.PairingRule{Kind: entities.PairingRequire, AuthorID: "u1", ReviewerID: "m1"})
+	require.NoError(t, err)
+
+	rules, err := repo.ListPairingRules(ctx, "m1")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "Pairing", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 2)
+	require.Equal(t, "m1", pr.Reviewers[0])
+	require.Contains(t, []string{"u3", "u4"}, pr.Reviewers[1])
+
+	_, _, err = repo.ReassignReviewer(ctx, "pr-1", "m1", selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrPairingViolation)
+
+	_, err = repo.DeactivateTeam(ctx, "mentors", false, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrPairingViolation)
+
+	_, err = repo.SetUserActive(ctx, "m1", false)
+	require.NoError(t, err)
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Mentor away", AuthorID: "u1"}, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrPairingViolation)
+
+	_, err = repo.DeletePairingRule(ctx, blocked.ID)
+	require.NoError(t, err)
+	_, err = repo.DeletePairingRule(ctx, blocked.ID)
+	require.ErrorIs(t, err, entities.ErrPairingRuleNotFound)
+}
+
+func TestReviewCapacityIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	maxOpen := 1
+	_, err = repo.SetUserCapacity(ctx, "missing", entities.ReviewCapacity{MaxOpenReviews: &maxOpen, Weight: 1})
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+	usr, err := repo.SetUserCapacity(ctx, "u2", entities.ReviewCapacity{MaxOpenReviews: &maxOpen, Weight: 0.5})
+	require.NoError(t, err)
+	require.NotNil(t, usr.Capacity)
+	require.Equal(t, &maxOpen, usr.Capacity.MaxOpenReviews)
+	require.Equal(t, 0.5, usr.Capacity.Weight)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3"}, pr.Reviewers)
+
+	preview, err := repo.PreviewAssignment(ctx, entities.PullRequest{ID: "pr-3", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Contains(t, preview.Excluded, entities.ExcludedCandidate{UserID: "u2", Reason: entities.ExcludedAtCapacity})
+
+	stats, err := repo.ReviewerStats(ctx, "u2", 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stats.OpenPRCnt)
+	require.True(t, stats.Capacity.Reached(stats.OpenPRCnt))
+
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-4", Name: "Third", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+}
+
+func TestSeniorReviewerIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	team, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dave", IsActive: true, Role: entities.RoleSenior},
+	}})
+	require.NoError(t, err)
+	roles := make(map[string]entities.Role, len(team.Members))
+	for _, m := range team.Members {
+		roles[m.ID] = m.Role
+	}
+	require.Equal(t, map[string]entities.Role{"u1": entities.RoleJunior, "u2": entities.RoleJunior, "u3": entities.RoleJunior, "u4": entities.RoleSenior}, roles)
+
+	policy, err := repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1, RequireSenior: true})
+	require.NoError(t, err)
+	require.True(t, policy.RequireSenior)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u4"}, pr.Reviewers)
+
+	_, _, err = repo.ReassignReviewer(ctx, "pr-1", "u4", selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrSeniorRequired)
+
+	_, err = repo.SetUserRole(ctx, "missing", entities.RoleLead)
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+	usr, err := repo.SetUserRole(ctx, "u3", entities.RoleLead)
+	require.NoError(t, err)
+	require.Equal(t, entities.RoleLead, usr.Role)
+
+	_, repl, err := repo.ReassignReviewer(ctx, "pr-1", "u4", selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, "u3", repl)
+}
+
+func TestManualReviewerChangesIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u3", Username: "Charlie", IsActive: true},
+		{ID: "u4", Username: "Dave", IsActive: false},
+		{ID: "u5", Username: "Eve", IsActive: true},
+		{ID: "u6", Username: "Frank", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.AddUnavailability(ctx, entities.Unavailability{
+		UserID: "u5", StartsAt: time.Now().Add(-time.Minute), EndsAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	maxOpen := 1
+	_, err = repo.SetUserCapacity(ctx, "u6", entities.ReviewCapacity{MaxOpenReviews: &maxOpen, Weight: 1})
+	require.NoError(t, err)
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-0", Name: "Zero", AuthorID: "u3"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u6"}, pr.Reviewers)
+
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.Reviewers)
+
+	_, err = repo.AddReviewer(ctx, "pr-1", "u1")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u4")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u5")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u6")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.ReplaceReviewer(ctx, "pr-1", "u2", "u5")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u2")
+	require.ErrorIs(t, err, entities.ErrAlreadyAssigned)
+	_, err = repo.AddReviewer(ctx, "pr-1", "missing")
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	pr, err = repo.AddReviewer(ctx, "pr-1", "u3")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+
+	_, err = repo.ReplaceReviewer(ctx, "pr-1", "u2", "u3")
+	require.ErrorIs(t, err, entities.ErrAlreadyAssigned)
+	pr, err = repo.RemoveReviewer(ctx, "pr-1", "u3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.Reviewers)
+	_, err = repo.RemoveReviewer(ctx, "pr-1", "u3")
+	require.ErrorIs(t, err, entities.ErrNotAssigned)
+
+	pr, err = repo.ReplaceReviewer(ctx, "pr-1", "u2", "u3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3"}, pr.Reviewers)
+
+	stats, err := repo.PRStats(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, stats.Reassignments, 3)
+	changes := make([][2]string, 0, len(stats.Reassignments))
+	for _, ev := range stats.Reassignments {
+		var change [2]string
+		if ev.OldReviewerID != nil {
+			change[0] = *ev.OldReviewerID
+		}
+		if ev.NewReviewerID != nil {
+			change[1] = *ev.NewReviewerID
+		}
+		changes = append(changes, change)
+	}
+	require.ElementsMatch(t, [][2]string{{"", "u3"}, {"u3", ""}, {"u2", "u3"}}, changes)
+
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u2")
+	require.ErrorIs(t, err, entities.ErrPRMerged)
+	_, err = repo.RemoveReviewer(ctx, "pr-1", "u3")
+	require.ErrorIs(t, err, entities.ErrPRMerged)
+	_, err = repo.ReplaceReviewer(ctx, "pr-1", "u3", "u2")
+	require.ErrorIs(t, err, entities.ErrPRMerged)
+}
+
+func TestReviewStatesIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviews, 2)
+	for _, r := range pr.Reviews {
+		require.Equal(t, entities.ReviewPending, r.State)
+		require.Nil(t, r.SubmittedAt)
+	}
+
+	pr, _, err = repo.SubmitReview(ctx, "pr-1", "u2", entities.ReviewApproved, selector.NewRandom())
+	require.NoError(t, err)
+	states := make(map[string]entities.ReviewState, len(pr.Reviews))
+	for _, r := range pr.Reviews {
+		states[r.ReviewerID] = r.State
+		if r.ReviewerID == "u2" {
+			require.NotNil(t, r.SubmittedAt)
+		}
+	}
+	require.Equal(t, map[string]entities.ReviewState{"u2": entities.ReviewApproved, "u3": entities.ReviewPending}, states)
+
+	_, _, err = repo.SubmitReview(ctx, "pr-1", "u1", entities.ReviewApproved, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrNotAssigned)
+
+	approved, err := repo.GetUserReviews(ctx, "u2", "", entities.ReviewApproved)
+	require.NoError(t, err)
+	require.Len(t, approved, 1)
+	require.Equal(t, entities.ReviewApproved, approved[0].ReviewState)
+	pending, err := repo.GetUserReviews(ctx, "u2", "", entities.ReviewPending)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// Without a replacement the decline is kept and the reviewer stays assigned.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	pr, repl, err := repo.SubmitReview(ctx, "pr-2", "u2", entities.ReviewDeclined, selector.NewRandom())
+	require.NoError(t, err)
+	require.Empty(t, repl)
+	require.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+	declined, err := repo.GetUserReviews(ctx, "u2", "", entities.ReviewDeclined)
+	require.NoError(t, err)
+	require.Len(t, declined, 1)
+
+	_, err = repo.AddTeamMember(ctx, "backend", entities.User{ID: "u4", Username: "Dana", IsActive: true}, false, selector.NewRandom())
+	require.NoError(t, err)
+	pr, repl, err = repo.SubmitReview(ctx, "pr-2", "u3", entities.ReviewDeclined, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, "u4", repl)
+	require.ElementsMatch(t, []string{"u2", "u4"}, pr.Reviewers)
+
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	_, _, err = repo.SubmitReview(ctx, "pr-1", "u3", entities.ReviewApproved, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrPRMerged)
+}
+
+func TestMergePolicyIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, Merge: entities.MergePolicy{
+		MinReviewers:           2,
+		RequireActiveReviewers: true,
+		MinAge:                 time.Hour,
+	}})
+	require.NoError(t, err)
+	policy, err := repo.GetTeamPolicy(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, entities.MergePolicy{MinReviewers: 2, RequireActiveReviewers: true, MinAge: time.Hour}, policy.Merge)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.Reviewers)
+	_, err = repo.SetUserActive(ctx, "u2", false)
+	require.NoError(t, err)
+
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.ErrorIs(t, err, entities.ErrMergeBlocked)
+	require.ErrorContains(t, err, "1 of 2 required reviewers assigned")
+	require.ErrorContains(t, err, "inactive reviewers u2")
+	require.ErrorContains(t, err, "younger than 1h0m0s")
+
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2})
+	require.NoError(t, err)
+	merged, err := repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusMerged, merged.Status)
+
+	// An already merged PR stays mergeable whatever the policy says.
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, Merge: entities.MergePolicy{MinReviewers: 2}})
+	require.NoError(t, err)
+	again, err := repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, merged.MergedAt, again.MergedAt)
+
+	// A merge reported by the code host has already happened and is recorded despite the policy.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.MergePR(ctx, "pr-2")
+	require.ErrorIs(t, err, entities.ErrMergeBlocked)
+	recorded, err := repo.RecordPRMerge(ctx, "pr-2")
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusMerged, recorded.Status)
+	require.NotNil(t, recorded.MergedAt)
+}
+
+func TestPRLifecycleIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	maxOpen := 1
+	_, err = repo.SetUserCapacity(ctx, "u2", entities.ReviewCapacity{MaxOpenReviews: &maxOpen, Weight: 1})
+	require.NoError(t, err)
+
+	draft, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1", Status: entities.StatusDraft}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusDraft, draft.Status)
+	require.Empty(t, draft.Reviewers)
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.ErrorIs(t, err, entities.ErrInvalidStatus)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u2")
+	require.ErrorIs(t, err, entities.ErrInvalidStatus)
+
+	ready, err := repo.MarkPRReady(ctx, entities.PullRequest{ID: "pr-1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusOpen, ready.Status)
+	require.Equal(t, []string{"u2"}, ready.Reviewers)
+	again, err := repo.MarkPRReady(ctx, entities.PullRequest{ID: "pr-1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, ready.Reviewers, again.Reviewers)
+
+	// u2 is at capacity until pr-1 is closed.
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Empty(t, pr.Reviewers)
+	closed, err := repo.ClosePR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusClosed, closed.Status)
+	require.NotNil(t, closed.ClosedAt)
+	closedAgain, err := repo.ClosePR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, closed.ClosedAt, closedAgain.ClosedAt)
+	events, err := repo.ListEvents(ctx, 0, entities.EventFilter{}, 100)
+	require.NoError(t, err)
+	closedEvents := 0
+	for _, ev := range events {
+		if ev.Type == entities.EventPRClosed {
+			closedEvents++
+		}
+	}
+	require.Equal(t, 1, closedEvents)
+	stats, err := repo.ReviewerStats(ctx, "u2", 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, stats.OpenPRCnt)
+	require.EqualValues(t, 1, stats.ClosedPRCnt)
+	pr, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-3", Name: "Third", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.Reviewers)
+
+	_, err = repo.MarkPRReady(ctx, entities.PullRequest{ID: "pr-1"}, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrInvalidStatus)
+	// u2 is full again because of pr-3, but nobody else can take over, so it stays.
+	reopened, err := repo.ReopenPR(ctx, "pr-1", selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusOpen, reopened.Status)
+	require.Nil(t, reopened.ClosedAt)
+	require.Equal(t, []string{"u2"}, reopened.Reviewers)
+
+	// Once u3 joins, reopening hands the review over instead of overloading u2.
+	_, err = repo.ClosePR(ctx, "pr-1")
+	require.NoError(t, err)
+	_, err = repo.AddTeamMember(ctx, "backend", entities.User{ID: "u3", Username: "Charlie", IsActive: true}, false, selector.NewRandom())
+	require.NoError(t, err)
+	reopened, err = repo.ReopenPR(ctx, "pr-1", selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusOpen, reopened.Status)
+	require.Equal(t, []string{"u3"}, reopened.Reviewers)
+	prStats, err := repo.PRStats(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), prStats.TransferCount)
+	require.NotNil(t, prStats.Reassignments[0].Reason)
+	require.Equal(t, entities.ReassignReopenedIneligible, *prStats.Reassignments[0].Reason)
+
+	// A draft closed before it was ready goes back to draft.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-4", Name: "Fourth", AuthorID: "u1", Status: entities.StatusDraft}, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.ReopenPR(ctx, "pr-4", selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrInvalidStatus)
+	_, err = repo.ClosePR(ctx, "pr-4")
+	require.NoError(t, err)
+	reopened, err = repo.ReopenPR(ctx, "pr-4", selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusDraft, reopened.Status)
+
+	_, err = repo.MergePR(ctx, "pr-2")
+	require.NoError(t, err)
+	_, err = repo.ClosePR(ctx, "pr-2")
+	require.ErrorIs(t, err, entities.ErrPRMerged)
+
+	all, err := repo.Stats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []entities.StatusStat{
+		{Status: entities.StatusDraft, PRCount: 1},
+		{Status: entities.StatusOpen, PRCount: 2},
+		{Status: entities.StatusMerged, PRCount: 1},
+		{Status: entities.StatusClosed, PRCount: 0},
+	}, all.ByStatus)
+}
+
+func TestReviewSLAIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1})
+	require.NoError(t, err)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 1)
+	stale := pr.Reviewers[0]
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.ClosePR(ctx, "pr-2")
+	require.NoError(t, err)
+
+	reviews, err := repo.ListStaleReviews(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Empty(t, reviews)
+
+	_, err = repo.db.Exec(ctx, `UPDATE pr_reviewers SET assigned_at = NOW() - INTERVAL '2 hours'`)
+	require.NoError(t, err)
+	_, err = repo.db.Exec(ctx, `UPDATE pull_requests SET ready_at = NOW() - INTERVAL '2 hours'`)
+	require.NoError(t, err)
+	reviews, err = repo.ListStaleReviews(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, "pr-1", reviews[0].PRID)
+	require.Equal(t, stale, reviews[0].ReviewerID)
+
+	reassigned, repl, err := repo.ReassignStaleReview(ctx, "pr-1", stale, time.Hour, selector.NewRandom())
+	require.NoError(t, err)
+	require.NotEqual(t, stale, repl)
+	require.Equal(t, []string{repl}, reassigned.Reviewers)
+	_, _, err = repo.ReassignStaleReview(ctx, "pr-1", repl, time.Hour, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrReviewNotStale)
+
+	stats, err := repo.PRStats(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, stats.Reassignments, 1)
+	require.NotNil(t, stats.Reassignments[0].Reason)
+	require.Equal(t, entities.ReassignSLAExpired, *stats.Reassignments[0].Reason)
+
+	// A submitted review is no longer waiting on the reviewer.
+	_, err = repo.db.Exec(ctx, `UPDATE pr_reviewers SET assigned_at = NOW() - INTERVAL '2 hours'`)
+	require.NoError(t, err)
+	_, _, err = repo.SubmitReview(ctx, "pr-1", repl, entities.ReviewApproved, selector.NewRandom())
+	require.NoError(t, err)
+	reviews, err = repo.ListStaleReviews(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Empty(t, reviews)
+}
+
+func TestWebhookOutboxIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	var mu sync.Mutex
+	received := make(map[string][]entities.WebhookPayload)
+	okSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(webhook.SignatureHeader) != webhook.Sign("ok-secret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var payload entities.WebhookPayload
+		_ = json.Unmarshal(body, &payload)
+		mu.Lock()
+		received[r.Header.Get(webhook.EventHeader)] = append(received[r.Header.Get(webhook.EventHeader)], payload)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(okSrv.Close)
+	failSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failSrv.Close)
+
+	ok, err := repo.AddWebhookSubscription(ctx, entities.WebhookSubscription{
+		URL: okSrv.URL, Secret: "ok-secret",
+		Events: []entities.WebhookEvent{entities.EventPRCreated, entities.EventReviewerReassigned, entities.EventPRMerged},
+	})
+	require.NoError(t, err)
+	failing, err := repo.AddWebhookSubscription(ctx, entities.WebhookSubscription{
+		URL: failSrv.URL, Secret: "fail-secret", Events: []entities.WebhookEvent{entities.EventPRMerged},
+	})
+	require.NoError(t, err)
+	subs, err := repo.ListWebhookSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1})
+	require.NoError(t, err)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	old := pr.Reviewers[0]
+	_, repl, err := repo.ReassignReviewer(ctx, "pr-1", old, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	// Repeated merges are no-ops and emit nothing.
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+
+	// Failed writes leave no events behind.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "Dup", AuthorID: "u1"}, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrPRExists)
+
+	deliveries, err := repo.ListWebhookDeliveries(ctx, entities.DeliveryFilter{Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 4)
+	for _, d := range deliveries {
+		require.Equal(t, entities.DeliveryPending, d.Status)
+		require.Zero(t, d.Attempts)
+	}
+
+	dispatcher := webhook.NewDispatcher(testLogger(t), repo, nil, webhook.Config{MaxAttempts: 3, BackoffBase: time.Hour})
+	delivered, err := dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, delivered)
+
+	// Leased and backed-off deliveries are not due again.
+	claimed, err := repo.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+
+	mu.Lock()
+	require.Len(t, received[string(entities.EventPRCreated)], 1)
+	require.Len(t, received[string(entities.EventReviewerReassigned)], 1)
+	require.Len(t, received[string(entities.EventPRMerged)], 1)
+	change := received[string(entities.EventReviewerReassigned)][0].Data.(map[string]any)
+	mu.Unlock()
+	require.Equal(t, "pr-1", change["pull_request_id"])
+	require.Equal(t, old, change["old_reviewer_id"])
+	require.Equal(t, repl, change["new_reviewer_id"])
+
+	status := entities.DeliveryDelivered
+	done, err := repo.ListWebhookDeliveries(ctx, entities.DeliveryFilter{SubscriptionID: &ok.ID, Status: &status, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, done, 3)
+	require.NotNil(t, done[0].DeliveredAt)
+	require.Equal(t, http.StatusOK, *done[0].ResponseCode)
+
+	pending, err := repo.ListWebhookDeliveries(ctx, entities.DeliveryFilter{SubscriptionID: &failing.ID, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, entities.DeliveryPending, pending[0].Status)
+	require.Equal(t, 1, pending[0].Attempts)
+	require.Equal(t, http.StatusServiceUnavailable, *pending[0].ResponseCode)
+	require.NotNil(t, pending[0].LastError)
+	require.True(t, pending[0].NextAttemptAt.After(time.Now().Add(30*time.Minute)))
+
+	_, err = repo.DeleteWebhookSubscription(ctx, failing.ID)
+	require.NoError(t, err)
+	_, err = repo.DeleteWebhookSubscription(ctx, failing.ID)
+	require.ErrorIs(t, err, entities.ErrWebhookNotFound)
+	pending, err = repo.ListWebhookDeliveries(ctx, entities.DeliveryFilter{SubscriptionID: &failing.ID, Limit: 50})
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestEventLogIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	last, err := repo.LastEventID(ctx)
+	require.NoError(t, err)
+	require.Zero(t, last)
+
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+
+	// Events are logged without any webhook subscriptions.
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	_, err = repo.AddReviewer(ctx, "pr-1", "u3")
+	require.NoError(t, err)
+	_, err = repo.AddWebhookSubscription(ctx, entities.WebhookSubscription{
+		URL: "http://127.0.0.1:1/hook", Secret: "secret", Events: []entities.WebhookEvent{entities.EventPRMerged},
+	})
+	require.NoError(t, err)
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+
+	all, err := repo.ListEvents(ctx, 0, entities.EventFilter{}, 100)
+	require.NoError(t, err)
+	types := make([]entities.WebhookEvent, 0, len(all))
+	for _, e := range all {
+		types = append(types, e.Type)
+	}
+	require.Equal(t, []entities.WebhookEvent{
+		entities.EventPRCreated, entities.EventReviewerAssigned, entities.EventReviewerAssigned, entities.EventPRMerged,
+	}, types)
+	require.Equal(t, []string{"u1", "u3"}, all[2].UserIDs)
+	require.Equal(t, []string{"backend", "frontend"}, all[2].TeamNames)
+	var payload entities.WebhookPayload
+	require.NoError(t, json.Unmarshal(all[3].Payload, &payload))
+	require.Equal(t, entities.EventPRMerged, payload.Event)
+
+	last, err = repo.LastEventID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, all[3].ID, last)
+
+	forU3, err := repo.ListEvents(ctx, 0, entities.EventFilter{UserID: "u3"}, 100)
+	require.NoError(t, err)
+	require.Len(t, forU3, 2)
+	require.Equal(t, all[2].ID, forU3[0].ID)
+	after, err := repo.ListEvents(ctx, all[2].ID, entities.EventFilter{TeamName: "frontend"}, 100)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	require.Equal(t, entities.EventPRMerged, after[0].Type)
+
+	_, err = repo.DeactivateTeam(ctx, "frontend", false, selector.NewRandom())
+	require.NoError(t, err)
+	deactivated, err := repo.ListEvents(ctx, last, entities.EventFilter{TeamName: "frontend"}, 100)
+	require.NoError(t, err)
+	require.Len(t, deactivated, 1)
+	require.Equal(t, entities.EventTeamDeactivated, deactivated[0].Type)
+	require.Equal(t, []string{"u3"}, deactivated[0].UserIDs)
+
+	// Pruning keeps the event with a pending delivery and the latest one.
+	pruned, err := repo.PruneEvents(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+	kept, err := repo.ListEvents(ctx, 0, entities.EventFilter{}, 100)
+	require.NoError(t, err)
+	require.Len(t, kept, 2)
+	require.Equal(t, entities.EventPRMerged, kept[0].Type)
+	require.Equal(t, deactivated[0].ID, kept[1].ID)
+	last, err = repo.LastEventID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, deactivated[0].ID, last)
+}
+
+func TestCodeHostSyncIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	// Without a syncer reviewer changes are not queued.
+	disabled := New(ctx, testLogger(t), cfg)
+	require.NoError(t, disabled.OnStart(ctx))
+	t.Cleanup(func() { _ = disabled.OnStop(ctx) })
+
+	_, err := disabled.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = disabled.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1})
+	require.NoError(t, err)
+	_, err = disabled.CreatePR(ctx, entities.PullRequest{ID: "octo-org/hello-world#0", Name: "Zero", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	syncs, err := disabled.ListCodeHostSyncs(ctx, entities.CodeHostSyncFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, syncs)
+	_, err = disabled.ClosePR(ctx, "octo-org/hello-world#0")
+	require.NoError(t, err)
+
+	cfg.GitHub = config.GitHubConfig{SyncInterval: time.Second, SyncRepositories: "octo-org/hello-world"}
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "octo-org/hello-world#1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	old := pr.Reviewers[0]
+
+	claimed, err := repo.ClaimCodeHostSyncs(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, entities.StatusOpen, claimed[0].PRStatus)
+	require.Equal(t, []string{old}, claimed[0].Reviewers)
+	// A leased sync is not handed out twice.
+	again, err := repo.ClaimCodeHostSyncs(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, again)
+
+	// The reviewers change while the push is in flight: its outcome must not mark the sync synced.
+	_, repl, err := repo.ReassignReviewer(ctx, pr.ID, old, selector.NewRandom())
+	require.NoError(t, err)
+	code := 201
+	require.NoError(t, repo.RecordCodeHostSync(ctx, entities.CodeHostSyncAttempt{
+		PRID: pr.ID, Version: claimed[0].Version, Status: entities.SyncSynced,
+		SyncedReviewers: claimed[0].Reviewers, ResponseCode: &code,
+	}))
+	pending := entities.SyncPending
+	syncs, err = repo.ListCodeHostSyncs(ctx, entities.CodeHostSyncFilter{Status: &pending, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, syncs, 1)
+	require.Zero(t, syncs[0].Attempts)
+	require.Equal(t, []string{old}, syncs[0].SyncedReviewers)
+
+	claimed, err = repo.ClaimCodeHostSyncs(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, []string{repl}, claimed[0].Reviewers)
+	require.Equal(t, []string{old}, claimed[0].SyncedReviewers)
+	require.NoError(t, repo.RecordCodeHostSync(ctx, entities.CodeHostSyncAttempt{
+		PRID: pr.ID, Version: claimed[0].Version, Status: entities.SyncSynced,
+		SyncedReviewers: claimed[0].Reviewers, ResponseCode: &code,
+	}))
+	syncs, err = repo.ListCodeHostSyncs(ctx, entities.CodeHostSyncFilter{PRID: pr.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, syncs, 1)
+	require.Equal(t, entities.SyncSynced, syncs[0].Status)
+	require.Equal(t, 1, syncs[0].Attempts)
+	require.Equal(t, []string{repl}, syncs[0].SyncedReviewers)
+	require.NotNil(t, syncs[0].SyncedAt)
+
+	// A failed attempt keeps the pushed reviewers and delays the retry.
+	_, err = repo.RemoveReviewer(ctx, pr.ID, repl)
+	require.NoError(t, err)
+	claimed, err = repo.ClaimCodeHostSyncs(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Empty(t, claimed[0].Reviewers)
+	code = 502
+	require.NoError(t, repo.RecordCodeHostSync(ctx, entities.CodeHostSyncAttempt{
+		PRID: pr.ID, Version: claimed[0].Version, Status: entities.SyncPending,
+		ResponseCode: &code, Error: "bad gateway", RetryIn: time.Hour,
+	}))
+	claimed, err = repo.ClaimCodeHostSyncs(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+	syncs, err = repo.ListCodeHostSyncs(ctx, entities.CodeHostSyncFilter{PRID: pr.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, entities.SyncPending, syncs[0].Status)
+	require.Equal(t, []string{repl}, syncs[0].SyncedReviewers)
+	require.Equal(t, "bad gateway", *syncs[0].LastError)
+	require.Equal(t, 502, *syncs[0].ResponseCode)
+
+	// Pruning keeps pending syncs and drops finished ones.
+	pr2, err := repo.CreatePR(ctx, entities.PullRequest{ID: "octo-org/hello-world#2", Name: "Second", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.NoError(t, repo.RecordCodeHostSync(ctx, entities.CodeHostSyncAttempt{
+		PRID: pr2.ID, Version: 1, Status: entities.SyncSynced, SyncedReviewers: pr2.Reviewers, ResponseCode: &code,
+	}))
+	pruned, err := repo.PruneCodeHostSyncs(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruned)
+	syncs, err = repo.ListCodeHostSyncs(ctx, entities.CodeHostSyncFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, syncs, 1)
+	require.Equal(t, pr.ID, syncs[0].PRID)
+}
+
+func TestTeamMembershipIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "backend", ReviewerCount: 1})
+	require.NoError(t, err)
+
+	// Creating a team no longer moves members of other teams silently.
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "qa", Members: []entities.User{{ID: "u2", Username: "Bob", IsActive: true}}})
+	require.ErrorIs(t, err, entities.ErrMemberOfOtherTeam)
+	_, err = repo.GetTeam(ctx, "qa")
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "First", AuthorID: "u1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 1)
+	moved := pr.Reviewers[0]
+	stays := "u2"
+	if moved == "u2" {
+		stays = "u3"
+	}
+
+	_, err = repo.AddTeamMember(ctx, "frontend", entities.User{ID: moved, Username: "Mover", IsActive: true}, false, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrMemberOfOtherTeam)
+	res, err := repo.AddTeamMember(ctx, "frontend", entities.User{ID: moved, Username: "Mover", IsActive: true}, true, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, "backend", res.FromTeam)
+	require.Equal(t, 1, res.Reassigned)
+	require.Zero(t, res.Removed)
+	require.Len(t, res.Team.Members, 2)
+	reviews, err := repo.GetUserReviews(ctx, stays, "", "")
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, "pr-1", reviews[0].ID)
+
+	_, err = repo.RemoveTeamMember(ctx, "backend", "u4", selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrNotTeamMember)
+	_, err = repo.RemoveTeamMember(ctx, "backend", "u1", selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrAuthorHasOpenPRs)
+
+	// Nobody in backend but the author is left to take over the review.
+	res, err = repo.RemoveTeamMember(ctx, "backend", stays, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, "backend", res.FromTeam)
+	require.Zero(t, res.Reassigned)
+	require.Equal(t, 1, res.Removed)
+	require.Len(t, res.Team.Members, 1)
+	removed, err := repo.getUser(ctx, stays)
+	require.NoError(t, err)
+	require.False(t, removed.IsActive)
+	require.Empty(t, removed.TeamName)
+	_, err = repo.CreatePR(ctx, entities.PullRequest{ID: "pr-2", Name: "Second", AuthorID: stays}, selector.NewRandom())
+	require.ErrorIs(t, err, entities.ErrUserNotFound)
+
+	// A removed member joins another team without forcing.
+	res, err = repo.AddTeamMember(ctx, "frontend", entities.User{ID: stays, Username: "Back", IsActive: true}, false, selector.NewRandom())
+	require.NoError(t, err)
+	require.Empty(t, res.FromTeam)
+	require.Len(t, res.Team.Members, 3)
+
+	_, err = repo.RenameTeam(ctx, "backend", "frontend")
+	require.ErrorIs(t, err, entities.ErrTeamExists)
+	_, err = repo.RenameTeam(ctx, "missing", "core")
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+	team, err := repo.RenameTeam(ctx, "backend", "core")
+	require.NoError(t, err)
+	require.Equal(t, "core", team.Name)
+	require.Len(t, team.Members, 1)
+	policy, err := repo.GetTeamPolicy(ctx, "core")
+	require.NoError(t, err)
+	require.Equal(t, 1, policy.ReviewerCount)
+	_, err = repo.GetTeam(ctx, "backend")
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+}
+
+func TestListingIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "backend", Members: []entities.User{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Charlie", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "frontend", Members: []entities.User{
+		{ID: "u4", Username: "Dana", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.SetUserActive(ctx, "u3", false)
+	require.NoError(t, err)
+
+	created := make(map[string]*entities.PullRequest)
+	for _, pr := range []entities.PullRequest{
+		{ID: "pr-1", Name: "First", AuthorID: "u1", Status: entities.StatusOpen},
+		{ID: "pr-2", Name: "Second", AuthorID: "u2", Status: entities.StatusOpen},
+		{ID: "pr-3", Name: "Third", AuthorID: "u1", Status: entities.StatusOpen},
+		{ID: "pr-4", Name: "Fourth", AuthorID: "u4", Status: entities.StatusOpen},
+	} {
+		res, err := repo.CreatePR(ctx, pr, selector.NewRandom())
+		require.NoError(t, err)
+		created[pr.ID] = res
+	}
+	_, err = repo.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+
+	ids := func(prs []entities.PullRequest) []string {
+		res := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			res = append(res, pr.ID)
+		}
+		return res
+	}
+
+	prs, err := repo.ListPRs(ctx, entities.PullRequestFilter{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-4", "pr-3"}, ids(prs))
+	require.Equal(t, created["pr-3"].Reviewers, prs[1].Reviewers)
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{
+		After: &entities.PullRequestCursor{CreatedAt: *prs[1].CreatedAt, ID: prs[1].ID},
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-2", "pr-1"}, ids(prs))
+
+	merged := entities.StatusMerged
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{Status: &merged, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-1"}, ids(prs))
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{AuthorID: "u1", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-3", "pr-1"}, ids(prs))
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{TeamName: "backend", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-3", "pr-2", "pr-1"}, ids(prs))
+	require.NotEmpty(t, created["pr-2"].Reviewers)
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{ReviewerID: created["pr-2"].Reviewers[0], Limit: 10})
+	require.NoError(t, err)
+	require.Contains(t, ids(prs), "pr-2")
+	prs, err = repo.ListPRs(ctx, entities.PullRequestFilter{CreatedFrom: created["pr-2"].CreatedAt, CreatedTo: created["pr-4"].CreatedAt, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-3", "pr-2"}, ids(prs))
+
+	pr, err := repo.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, entities.StatusMerged, pr.Status)
+	require.Len(t, pr.Reviews, len(pr.Reviewers))
+	_, err = repo.GetPR(ctx, "missing")
+	require.ErrorIs(t, err, entities.ErrPRNotFound)
+
+	users, err := repo.ListUsers(ctx, entities.UserFilter{TeamName: "backend", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, "u1", users[0].ID)
+	require.Equal(t, "backend", users[0].TeamName)
+	users, err = repo.ListUsers(ctx, entities.UserFilter{TeamName: "backend", AfterID: "u2", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, "u3", users[0].ID)
+	active := false
+	users, err = repo.ListUsers(ctx, entities.UserFilter{IsActive: &active, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, "u3", users[0].ID)
+	users, err = repo.ListUsers(ctx, entities.UserFilter{Query: "DAN", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, "u4", users[0].ID)
+	users, err = repo.ListUsers(ctx, entities.UserFilter{Query: "%", Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, users)
+
+	teams, err := repo.ListTeams(ctx, entities.TeamFilter{Query: "END", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []entities.TeamSummary{
+		{Name: "backend", Members: 3, ActiveMembers: 2},
+		{Name: "frontend", Members: 1, ActiveMembers: 1},
+	}, teams)
+	teams, err = repo.ListTeams(ctx, entities.TeamFilter{AfterName: "backend", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, "frontend", teams[0].Name)
+}
+
+func TestTeamHierarchyIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, cleanup := setupPostgres(t)
+	t.Cleanup(cleanup)
+
+	repo := New(ctx, testLogger(t), cfg)
+	require.NoError(t, repo.OnStart(ctx))
+	t.Cleanup(func() { _ = repo.OnStop(ctx) })
+
+	_, err := repo.CreateTeam(ctx, entities.Team{Name: "engineering", Members: []entities.User{
+		{ID: "e1", Username: "Erin", IsActive: false},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "payments", Parent: "engineering", Members: []entities.User{
+		{ID: "p1", Username: "Paul", IsActive: true},
+		{ID: "p2", Username: "Peggy", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "billing", Parent: "payments", Members: []entities.User{
+		{ID: "b1", Username: "Bill", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "mobile", Members: []entities.User{
+		{ID: "m1", Username: "Alice", IsActive: true},
+		{ID: "m2", Username: "Bob", IsActive: true},
+	}})
+	require.NoError(t, err)
+	_, err = repo.CreateTeam(ctx, entities.Team{Name: "orphan", Parent: "missing"})
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+
+	team, err := repo.GetTeam(ctx, "billing")
+	require.NoError(t, err)
+	require.Equal(t, "payments", team.Parent)
+
+	_, err = repo.SetTeamParent(ctx, "engineering", "billing")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = repo.SetTeamParent(ctx, "payments", "missing")
+	require.ErrorIs(t, err, entities.ErrTeamNotFound)
+
+	descendants, err := repo.ListTeamDescendants(ctx, "engineering")
+	require.NoError(t, err)
+	require.Len(t, descendants, 2)
+	require.Equal(t, "payments", descendants[0].Name)
+	require.Equal(t, "engineering", descendants[0].Parent)
+	require.Len(t, descendants[0].Members, 2)
+	require.Equal(t, "billing", descendants[1].Name)
+	require.Equal(t, "payments", descendants[1].Parent)
+	require.Equal(t, "b1", descendants[1].Members[0].ID)
+
+	teams, err := repo.ListTeams(ctx, entities.TeamFilter{Query: "pay", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []entities.TeamSummary{{Name: "payments", Parent: "engineering", Members: 2, ActiveMembers: 2}}, teams)
+
+	// The department has no active members of its own and lends reviewers from the teams below it.
+	_, err = repo.SetTeamPolicy(ctx, entities.TeamPolicy{TeamName: "mobile", ReviewerCount: 2, FallbackTeams: []string{"engineering"}})
+	require.NoError(t, err)
+	pr, err := repo.CreatePR(ctx, entities.PullRequest{ID: "pr-1", Name: "Hierarchy", AuthorID: "m1"}, selector.NewRandom())
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 2)
+	require.Equal(t, "m2", pr.Reviewers[0])
+	require.Contains(t, []string{"p1", "p2", "b1"}, pr.Reviewers[1])
+	require.Equal(t, []string{"engineering"}, pr.FallbackTeams)
+
+	assignments := func(rollup bool) map[string]int64 {
+		summary, err := repo.StatsSummary(ctx, entities.StatsFilter{Rollup: rollup})
+		require.NoError(t, err)
+		res := make(map[string]int64)
+		for _, s := range summary.TeamAssignments {
+			res[s.TeamName] = s.AssignCnt
+		}
+		return res
+	}
+	flat := assignments(false)
+	require.Equal(t, int64(1), flat["mobile"])
+	require.NotContains(t, flat, "engineering")
+	rolled := assignments(true)
+	require.Equal(t, int64(1), rolled["mobile"])
+	require.Equal(t, int64(1), rolled["engineering"])
+
+	res, err := repo.DeactivateTeam(ctx, "engineering", true, selector.NewRandom())
+	require.NoError(t, err)
+	require.Equal(t, 3, res.DeactivatedUsers)
+	require.Equal(t, 0, res.Reassigned)
+	require.Equal(t, 1, res.Removed)
+	team, err = repo.GetTeam(ctx, "billing")
+	require.NoError(t, err)
+	require.False(t, team.Members[0].IsActive)
+
+	team, err = repo.SetTeamParent(ctx, "billing", "")
+	require.NoError(t, err)
+	require.Empty(t, team.Parent)
+	descendants, err = repo.ListTeamDescendants(ctx, "engineering")
+	require.NoError(t, err)
+	require.Len(t, descendants, 1)
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// blockedFilter matches users of a row aliased as u blocked from reviewing PRs of author $2.
+const blockedFilter = `EXISTS (
+    SELECT 1 FROM pairing_rules b
+    WHERE b.kind = 'block' AND b.author_id = $2 AND b.reviewer_id = u.id
+)`
+
+// requiredFilter matches users of a row aliased as u required to review PRs of author $2.
+const requiredFilter = `EXISTS (
+    SELECT 1 FROM pairing_rules q
+    WHERE q.kind = 'require' AND q.author_id = $2 AND q.reviewer_id = u.id
+)`
+
+// selectExcludedQuery lists the author and users of the assignment scope who cannot review right now.
+// Without exclude_author_team the scope is the author team and the subtrees of its fallback teams.
+// Required reviewers are assigned regardless of their cap, so they are never reported as at capacity.
+const selectExcludedQuery = `
+WITH RECURSIVE fallback AS (
+    SELECT fallback_team_id AS id FROM team_fallbacks WHERE team_id=$1
+    UNION
+    SELECT c.id FROM teams c JOIN fallback f ON c.parent_id = f.id
+)
+SELECT u.id,
+    CASE WHEN u.id = $2 THEN 'author'
+         WHEN ` + blockedFilter + ` THEN 'blocked'
+         WHEN NOT u.is_active THEN 'inactive'
+         WHEN NOT ` + availableFilter + ` THEN 'unavailable'
+         ELSE 'at_capacity' END
+FROM users u
+WHERE u.id = $2
+   OR ((CASE WHEN $3::boolean THEN u.team_id <> $1 ELSE u.team_id = $1 OR u.team_id IN (SELECT id FROM fallback) END)
+       AND (NOT u.is_active OR NOT ` + availableFilter + ` OR ` + blockedFilter + `
+            OR (NOT ` + capacityFilter + ` AND NOT ` + requiredFilter + `)))
+ORDER BY u.id`
+
+// PreviewAssignment runs the CreatePR selection for pr in a read-only transaction without persisting anything.
+// The transaction reads one snapshot instead of taking the assignment locks, so a preview never waits
+// for concurrent assignments.
+func (p *Postgres) PreviewAssignment(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.AssignmentPreview, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var authorTeamID int64
+	var authorActive bool
+	if err := tx.QueryRow(ctx, selectAuthorQuery, pr.AuthorID).Scan(&authorTeamID, &authorActive); err != nil {
+		p.log.Errorw("failed to query author team", "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("author lookup: %w", err)
+	}
+	if !authorActive {
+		return nil, fmt.Errorf("%w: author inactive", entities.ErrInvalidArgument)
+	}
+
+	plan, _, err := p.planAssignment(ctx, tx, pr, authorTeamID, sel, true)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, selectExcludedQuery, authorTeamID, pr.AuthorID, plan.Policy.ExcludeAuthorTeam)
+	if err != nil {
+		p.log.Errorw("failed to select excluded users", "error", err)
+		return nil, fmt.Errorf("select excluded users: %w", err)
+	}
+	plan.Excluded, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.ExcludedCandidate, error) {
+		var e entities.ExcludedCandidate
+		err := row.Scan(&e.UserID, &e.Reason)
+		return e, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan excluded users", "error", err)
+		return nil, fmt.Errorf("scan excluded users: %w", err)
+	}
+
+	return &plan, nil
+}
@@ -2,10 +2,8 @@ package postgres
 
 import (
 	"context"
-	"crypto/rand"
 	"errors"
 	"fmt"
-	"math/big"
 	"time"
 
 	"assigning-reviewers-for-pr/internal/entities"
@@ -14,21 +12,53 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// candidateColumns projects a users row aliased as u into (id, total assignments, open reviews, skills, schedule, weight, role).
+const candidateColumns = `u.id,
+    (SELECT COUNT(*) FROM pr_reviewers r WHERE r.reviewer_id = u.id),
+    ` + openReviewsColumn + `,
+    ` + userSkillsColumn + `, ` + scheduleColumns + `, u.review_weight, u.role`
+
+// assignmentLockClass namespaces advisory locks taken around reviewer selection.
+const assignmentLockClass = 4201
+
 const (
-	selectAuthorQuery                = `SELECT u.team_id, u.is_active FROM users u WHERE u.id=$1`
-	insertPRQuery                    = `INSERT INTO pull_requests(id, name, author_id, status) VALUES ($1,$2,$3,'OPEN')`
-	selectCandidatesQuery            = `SELECT id FROM users WHERE team_id=$1 AND is_active=true AND id <> $2`
-	selectPRForUpdateQuery           = `SELECT id, name, author_id, status, created_at, merged_at FROM pull_requests WHERE id=$1 FOR UPDATE`
-	updatePRMergedQuery              = `UPDATE pull_requests SET status='MERGED', merged_at=NOW() WHERE id=$1 RETURNING merged_at`
-	selectReviewersQuery             = `SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1`
-	deleteReviewerQuery              = `DELETE FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2`
-	insertReviewerQuery              = `INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES ($1,$2)`
-	selectReviewerTeamQuery          = `SELECT team_id FROM users WHERE id=$1`
-	selectReplacementCandidatesQuery = `SELECT id FROM users WHERE team_id=$1 AND is_active=true AND id <> $2`
+	// Members removed from their team are not found as authors.
+	selectAuthorQuery = `SELECT u.team_id, u.is_active FROM users u WHERE u.id=$1 AND u.team_id IS NOT NULL`
+	// shareAuthorQuery keeps the author in their team until a new, ready or reopened PR is committed.
+	shareAuthorQuery = selectAuthorQuery + ` FOR SHARE`
+	insertPRQuery    = `
+INSERT INTO pull_requests(id, name, author_id, status, ready_at)
+VALUES ($1, $2, $3, $4, CASE WHEN $4 = 'OPEN' THEN NOW() END)`
+	selectCandidatesQuery = `SELECT ` + candidateColumns + `
+FROM users u
+WHERE u.team_id=$1 AND u.is_active=true AND u.id <> $2 AND ` + availableFilter + ` AND ` + capacityFilter
+	selectPRQuery                  = `SELECT id, name, author_id, status, created_at, merged_at, closed_at FROM pull_requests WHERE id=$1`
+	selectPRForUpdateQuery         = selectPRQuery + ` FOR UPDATE`
+	updatePRMergedQuery            = `UPDATE pull_requests SET status='MERGED', merged_at=NOW() WHERE id=$1 RETURNING merged_at`
+	selectReviewersQuery           = `SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1`
+	deleteReviewerQuery            = `DELETE FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2`
+	insertReviewerQuery            = `INSERT INTO pr_reviewers(pr_id, reviewer_id, fallback_team_id) VALUES ($1,$2,$3)`
+	selectReviewerTeamQuery        = `SELECT team_id, role FROM users WHERE id=$1 AND team_id IS NOT NULL`
+	selectOtherTeamCandidatesQuery = `SELECT ` + candidateColumns + `
+FROM users u
+WHERE u.is_active=true AND u.team_id <> $1 AND u.id <> $2 AND ` + availableFilter + ` AND ` + capacityFilter
+	lockTeamAssignmentsQuery  = `SELECT pg_advisory_xact_lock($1, $2)`
+	insertPRLabelQuery        = `INSERT INTO pr_labels(pr_id, label) VALUES ($1,$2) ON CONFLICT DO NOTHING`
+	selectPRLabelsQuery       = `SELECT label FROM pr_labels WHERE pr_id=$1 ORDER BY label`
+	reviewersCoverLabelsQuery = `
+SELECT EXISTS (
+    SELECT 1 FROM pr_reviewers r
+    WHERE r.pr_id = $1 AND r.reviewer_id <> $2
+      AND NOT EXISTS (
+          SELECT 1 FROM unnest($3::text[]) AS l(label)
+          WHERE l.label NOT IN (SELECT s.skill FROM user_skills s WHERE s.user_id = r.reviewer_id)
+      )
+)`
 )
 
-// CreatePR creates PR and assigns up to two reviewers.
-func (p *Postgres) CreatePR(ctx context.Context, pr entities.PullRequest) (res *entities.PullRequest, err error) {
+// CreatePR creates PR and assigns reviewers chosen by the selector according to the author team policy.
+// A PR created as a draft gets no reviewers until it is marked ready.
+func (p *Postgres) CreatePR(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (res *entities.PullRequest, err error) {
 	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
@@ -37,7 +67,7 @@ func (p *Postgres) CreatePR(ctx context.Context, pr entities.PullRequest) (res *
 
 	var authorTeamID int64
 	var authorActive bool
-	if err := tx.QueryRow(ctx, selectAuthorQuery, pr.AuthorID).Scan(&authorTeamID, &authorActive); err != nil {
+	if err := tx.QueryRow(ctx, shareAuthorQuery, pr.AuthorID).Scan(&authorTeamID, &authorActive); err != nil {
 		p.log.Errorw("failed to query author team", "error", err)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, entities.ErrUserNotFound
@@ -49,7 +79,10 @@ func (p *Postgres) CreatePR(ctx context.Context, pr entities.PullRequest) (res *
 		return nil, fmt.Errorf("%w: author inactive", entities.ErrInvalidArgument)
 	}
 
-	if _, err := tx.Exec(ctx, insertPRQuery, pr.ID, pr.Name, pr.AuthorID); err != nil {
+	if pr.Status != entities.StatusDraft {
+		pr.Status = entities.StatusOpen
+	}
+	if _, err := tx.Exec(ctx, insertPRQuery, pr.ID, pr.Name, pr.AuthorID, pr.Status); err != nil {
 		var pgErr *pgconn.PgError
 		p.log.Errorw("failed to insert pull request", "error", err, "id", pr.ID)
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -57,76 +90,84 @@ func (p *Postgres) CreatePR(ctx context.Context, pr entities.PullRequest) (res *
 		}
 		return nil, fmt.Errorf("insert pr: %w", err)
 	}
-
-	candidatesRows, err := tx.Query(ctx, selectCandidatesQuery, authorTeamID, pr.AuthorID)
-	if err != nil {
-		p.log.Errorw("failed to select candidates", "error", err)
-		return nil, fmt.Errorf("select candidates: %w", err)
-	}
-	defer candidatesRows.Close()
-	candidates := make([]string, 0)
-	for candidatesRows.Next() {
-		var id string
-		if err := candidatesRows.Scan(&id); err != nil {
-			p.log.Errorw("failed to scan candidate", "error", err)
-			return nil, err
+	for _, l := range pr.Labels {
+		if _, err := tx.Exec(ctx, insertPRLabelQuery, pr.ID, l); err != nil {
+			p.log.Errorw("failed to insert pr label", "error", err, "pr_id", pr.ID, "label", l)
+			return nil, fmt.Errorf("insert pr label: %w", err)
 		}
-		candidates = append(candidates, id)
-	}
-	if err := candidatesRows.Err(); err != nil {
-		p.log.Errorw("error iterating candidates", "error", err)
-		return nil, err
 	}
 
-	reviewers := pickRandom(candidates, 2)
-	for _, r := range reviewers {
-		if _, err := tx.Exec(ctx, insertReviewerQuery, pr.ID, r); err != nil {
-			p.log.Errorw("failed to insert reviewer", "error", err, "reviewer_id", r)
-			return nil, fmt.Errorf("insert reviewer: %w", err)
+	var plan entities.AssignmentPreview
+	if pr.Status == entities.StatusOpen {
+		if plan, err = p.assignReviewers(ctx, tx, pr, authorTeamID, sel); err != nil {
+			return nil, err
 		}
 	}
+	reviewers := plan.Reviewers
+	if reviewers == nil {
+		reviewers = make([]string, 0)
+	}
 
 	var createdAt time.Time
 	if err := tx.QueryRow(ctx, `SELECT created_at FROM pull_requests WHERE id=$1`, pr.ID).Scan(&createdAt); err != nil {
 		p.log.Errorw("failed to select created_at", "error", err, "pr_id", pr.ID)
 		return nil, fmt.Errorf("select created_at: %w", err)
 	}
+	if pr.Reviews, err = p.readReviews(ctx, tx, pr.ID); err != nil {
+		return nil, err
+	}
+	pr.Reviewers = reviewers
+	if err := p.publishPREvent(ctx, tx, entities.EventPRCreated, pr); err != nil {
+		return nil, err
+	}
+	if err := p.publishReviewersAssigned(ctx, tx, pr, reviewers); err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
-	pr.Reviewers = reviewers
-	pr.Status = entities.StatusOpen
+	pr.FallbackTeams = plan.FallbackTeams
 	pr.CreatedAt = &createdAt
-	p.log.Infow("pr created", "pr_id", pr.ID, "reviewers", reviewers)
+	p.log.Infow("pr created", "pr_id", pr.ID, "status", pr.Status, "reviewers", reviewers, "fallback_teams", pr.FallbackTeams)
 	return &pr, nil
 }
 
-// MergePR marks PR merged idempotently.
-func (p *Postgres) MergePR(ctx context.Context, prID string) (res *entities.PullRequest, err error) {
+// MergePR marks an open PR merged idempotently, enforcing the merge policy of the author team.
+func (p *Postgres) MergePR(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	return p.mergePR(ctx, prID, true)
+}
+
+// RecordPRMerge marks an open PR merged idempotently without the merge policy,
+// for a merge that has already happened on the code host.
+func (p *Postgres) RecordPRMerge(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	return p.mergePR(ctx, prID, false)
+}
+
+// mergePR implements MergePR and RecordPRMerge; enforcePolicy applies the merge policy.
+func (p *Postgres) mergePR(ctx context.Context, prID string, enforcePolicy bool) (res *entities.PullRequest, err error) {
 	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	var pr entities.PullRequest
-	var createdAt time.Time
-	var mergedAt *time.Time
-	if err := tx.QueryRow(ctx, selectPRForUpdateQuery, prID).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt); err != nil {
-		p.log.Errorw("failed to select pr for update", "error", err, "pr_id", prID)
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, entities.ErrPRNotFound
-		}
-		return nil, fmt.Errorf("get pr: %w", err)
+	pr, err := p.lockPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
 	}
 
-	pr.CreatedAt = &createdAt
-	pr.MergedAt = mergedAt
-
 	if pr.Status != entities.StatusMerged {
+		if pr.Status != entities.StatusOpen {
+			p.log.Errorw("cannot merge a PR that is not open", "pr_id", prID, "status", pr.Status)
+			return nil, fmt.Errorf("%w: %s is %s", entities.ErrInvalidStatus, prID, pr.Status)
+		}
+		if enforcePolicy {
+			if err := p.checkMergePolicy(ctx, tx, pr); err != nil {
+				return nil, err
+			}
+		}
 		var now time.Time
 		if err := tx.QueryRow(ctx, updatePRMergedQuery, prID).Scan(&now); err != nil {
 			p.log.Errorw("failed to update pr merged", "error", err, "pr_id", prID)
@@ -134,52 +175,43 @@ func (p *Postgres) MergePR(ctx context.Context, prID string) (res *entities.Pull
 		}
 		pr.Status = entities.StatusMerged
 		pr.MergedAt = &now
+		if err := p.publishPREvent(ctx, tx, entities.EventPRMerged, pr); err != nil {
+			return nil, err
+		}
 	}
 
-	reviewers, err := p.readReviewers(ctx, tx, prID)
-	if err != nil {
-
+	if err := p.completePR(ctx, tx, &pr); err != nil {
 		return nil, err
 	}
-	pr.Reviewers = reviewers
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
-	p.log.Infow("pr merged", "pr_id", prID)
+	p.log.Infow("pr merged", "pr_id", prID, "policy_enforced", enforcePolicy)
 	return &pr, nil
 }
 
-// ReassignReviewer replaces reviewer with another active member of same team.
-func (p *Postgres) ReassignReviewer(ctx context.Context, prID, oldUserID string) (res *entities.PullRequest, repl string, err error) {
+// ReassignReviewer replaces reviewer with another active member of same team chosen by the selector.
+// When that team has no candidate left, the author team's fallback teams are tried in order.
+func (p *Postgres) ReassignReviewer(ctx context.Context, prID, oldUserID string, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	return p.reassignReviewer(ctx, prID, oldUserID, sel, 0)
+}
+
+// reassignReviewer implements ReassignReviewer. A positive sla limits it to a pending review
+// waiting longer than sla and records the change as sla_expired.
+func (p *Postgres) reassignReviewer(ctx context.Context, prID, oldUserID string, sel entities.ReviewerSelector, sla time.Duration) (res *entities.PullRequest, repl string, err error) {
 	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, "", err
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	var pr entities.PullRequest
-	var createdAt time.Time
-	if err := tx.QueryRow(ctx, selectPRForUpdateQuery, prID).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &createdAt, &pr.MergedAt); err != nil {
-		p.log.Errorw("failed to select pr for update", "error", err, "pr_id", prID)
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, "", entities.ErrPRNotFound
-		}
-		return nil, "", fmt.Errorf("get pr: %w", err)
-	}
-	pr.CreatedAt = &createdAt
-
-	if pr.Status == entities.StatusMerged {
-		return nil, "", entities.ErrPRMerged
-	}
-
-	reviewers, err := p.readReviewers(ctx, tx, prID)
+	pr, err := p.lockOpenPR(ctx, tx, prID)
 	if err != nil {
 		return nil, "", err
 	}
-	pr.Reviewers = reviewers
+	reviewers := pr.Reviewers
 
 	assigned := false
 	for _, r := range reviewers {
@@ -192,9 +224,34 @@ func (p *Postgres) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		p.log.Errorw("old reviewer not assigned to PR", "pr_id", prID, "old_reviewer", oldUserID)
 		return nil, "", entities.ErrNotAssigned
 	}
+	var reason entities.ReassignReason
+	if sla > 0 {
+		if err := p.checkReviewStale(ctx, tx, prID, oldUserID, sla); err != nil {
+			return nil, "", err
+		}
+		reason = entities.ReassignSLAExpired
+	}
+
+	res, repl, err = p.replaceReviewer(ctx, tx, pr, oldUserID, sel, reason)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, "", err
+	}
+	return res, repl, nil
+}
+
+// replaceReviewer swaps reviewer oldUserID of the PR locked by tx for a replacement picked as
+// ReassignReviewer describes, recording the change with reason, and returns the updated PR.
+// It fails before writing anything when no replacement fits.
+func (p *Postgres) replaceReviewer(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, oldUserID string, sel entities.ReviewerSelector, reason entities.ReassignReason) (*entities.PullRequest, string, error) {
+	prID := pr.ID
+	reviewers := pr.Reviewers
 
 	var teamID int64
-	if err := tx.QueryRow(ctx, selectReviewerTeamQuery, oldUserID).Scan(&teamID); err != nil {
+	var oldRole entities.Role
+	if err := tx.QueryRow(ctx, selectReviewerTeamQuery, oldUserID).Scan(&teamID, &oldRole); err != nil {
 		p.log.Errorw("failed to select old reviewer team", "error", err, "old_reviewer", oldUserID)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, "", entities.ErrUserNotFound
@@ -202,61 +259,253 @@ func (p *Postgres) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		return nil, "", fmt.Errorf("old reviewer lookup: %w", err)
 	}
 
-	rows, err := tx.Query(ctx, selectReplacementCandidatesQuery, teamID, pr.AuthorID)
+	var authorTeamID int64
+	var authorActive bool
+	if err := tx.QueryRow(ctx, selectAuthorQuery, pr.AuthorID).Scan(&authorTeamID, &authorActive); err != nil {
+		p.log.Errorw("failed to query author team", "error", err, "pr_id", prID)
+		return nil, "", fmt.Errorf("author lookup: %w", err)
+	}
+	policy, err := p.readTeamPolicy(ctx, tx, authorTeamID, "")
 	if err != nil {
-		p.log.Errorw("failed to select replacements", "error", err, "pr_id", prID)
-		return nil, "", fmt.Errorf("select replacements: %w", err)
+		return nil, "", err
+	}
+	fallbacks, err := p.readFallbackTeams(ctx, tx, authorTeamID)
+	if err != nil {
+		return nil, "", err
 	}
-	defer rows.Close()
 
-	candidates := make([]string, 0)
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+	pairing, err := p.readAuthorPairing(ctx, tx, pr.AuthorID)
+	if err != nil {
+		return nil, "", err
+	}
+	if pairing.requires(oldUserID) {
+		p.log.Errorw("reviewer is required by a pairing rule", "pr_id", prID, "old_reviewer", oldUserID)
+		return nil, "", fmt.Errorf("%w: %s is a required reviewer of %s", entities.ErrPairingViolation, oldUserID, pr.AuthorID)
+	}
+
+	existing := pairing.exclude()
+	for _, r := range reviewers {
+		existing[r] = struct{}{}
+	}
+	// The pool of every other team already covers the fallback teams.
+	useFallbacks := !(policy.ExcludeAuthorTeam && teamID == authorTeamID)
+	var candidates []entities.Candidate
+	if useFallbacks {
+		if err := p.lockAssignmentScope(ctx, tx, teamID, fallbacks); err != nil {
 			return nil, "", err
 		}
-		already := false
-		for _, r := range reviewers {
-			if r == id {
-				already = true
-				break
-			}
-		}
-		if !already {
-			candidates = append(candidates, id)
+		candidates, err = p.readTeamCandidates(ctx, tx, teamID, pr.AuthorID, existing)
+	} else {
+		candidates, err = p.readAssignmentPool(ctx, tx, policy, authorTeamID, pr.AuthorID, existing)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Without another senior left the replacement should be senior; replacing the last senior
+	// with a junior is refused outright.
+	needSenior := false
+	if policy.RequireSenior {
+		hasOther, err := p.hasSeniorReviewer(ctx, tx, prID, []string{oldUserID})
+		if err != nil {
+			return nil, "", err
 		}
+		needSenior = !hasOther
 	}
-	if err := rows.Err(); err != nil {
+	strictSenior := needSenior && oldRole.IsSenior()
+	var keep func(entities.Candidate) bool
+	if strictSenior {
+		keep = isSenior
+		candidates = filterPool(candidates, keep)
+	}
+
+	if pr.Labels, err = p.readPRLabels(ctx, tx, prID); err != nil {
+		return nil, "", err
+	}
+	labels, err := p.replacementLabels(ctx, tx, prID, oldUserID, pr.Labels)
+	if err != nil {
 		return nil, "", err
 	}
 
-	if len(candidates) == 0 {
+	req := entities.SelectionRequest{Mode: policy.SelectionMode, Candidates: candidates, Labels: labels, Count: 1, RequireSenior: needSenior}
+	picked := sel.Select(req)
+	var fallbackTeamID *int64
+	for _, f := range fallbacks {
+		// A replacement from a fallback team's own members is still a fallback pick.
+		if f.id == teamID && len(picked) > 0 {
+			fallbackTeamID = &teamID
+		}
+	}
+	if len(picked) == 0 && useFallbacks {
+		picks, err := p.topUpFromFallbacks(ctx, tx, sel, req, fallbacks, teamID, pr.AuthorID, existing, keep)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(picks) > 0 {
+			picked = []string{picks[0].userID}
+			fallbackTeamID = &picks[0].team.id
+		}
+	}
+	if len(picked) == 0 && strictSenior {
+		p.log.Errorw("no senior replacement for the last senior reviewer", "pr_id", prID, "old_reviewer", oldUserID)
+		return nil, "", fmt.Errorf("%w: %s is the last senior reviewer of %s", entities.ErrSeniorRequired, oldUserID, prID)
+	}
+	if len(picked) == 0 {
 		return nil, "", entities.ErrNoCandidate
 	}
-
-	repl = pickRandom(candidates, 1)[0]
+	repl := picked[0]
 
 	if _, err := tx.Exec(ctx, deleteReviewerQuery, prID, oldUserID); err != nil {
 		return nil, "", fmt.Errorf("delete old reviewer: %w", err)
 	}
-	if _, err := tx.Exec(ctx, insertReviewerQuery, prID, repl); err != nil {
+	if _, err := tx.Exec(ctx, insertReviewerQuery, prID, repl, fallbackTeamID); err != nil {
 		return nil, "", fmt.Errorf("insert replacement: %w", err)
 	}
-	if err := p.insertReassignmentHistory(ctx, tx, prID, oldUserID, &repl); err != nil {
+	if err := p.insertReassignmentHistory(ctx, tx, prID, &oldUserID, &repl, fallbackTeamID, reason); err != nil {
+		return nil, "", err
+	}
+	change := entities.WebhookReviewerChange{PRID: prID, OldReviewerID: oldUserID, NewReviewerID: repl, Reason: reason}
+	if err := p.publishReviewerChange(ctx, tx, pr.AuthorID, change); err != nil {
 		return nil, "", err
 	}
 
 	reviewers = append(filterOut(reviewers, oldUserID), repl)
 	pr.Reviewers = reviewers
-
-	if err := tx.Commit(ctx); err != nil {
+	if err := p.completePR(ctx, tx, &pr); err != nil {
 		return nil, "", err
 	}
-
-	p.log.Infow("reviewer reassigned", "pr_id", prID, "old", oldUserID, "new", repl)
+	p.log.Infow("reviewer reassigned", "pr_id", prID, "old", oldUserID, "new", repl, "fallback_team_id", fallbackTeamID, "reason", reason)
 	return &pr, repl, nil
 }
 
+// replacementLabels returns the labels a replacement of oldUserID should be routed by:
+// none when the other reviewers of the PR already cover all of them.
+func (p *Postgres) replacementLabels(ctx context.Context, tx pgx.Tx, prID, oldUserID string, labels []string) ([]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	var covered bool
+	if err := tx.QueryRow(ctx, reviewersCoverLabelsQuery, prID, oldUserID, labels).Scan(&covered); err != nil {
+		p.log.Errorw("failed to check label coverage", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("check label coverage: %w", err)
+	}
+	if covered {
+		return nil, nil
+	}
+	return labels, nil
+}
+
+// planAssignment loads the author team policy and candidate pools and runs the selector for a new PR.
+// Reviewers required by pairing rules take the first slots regardless of their review cap,
+// and blocked ones are never candidates.
+// Slots the regular pool cannot fill are topped up from the fallback teams, returned as picks.
+// When the policy requires a senior reviewer and the regular pool has none, a senior from the
+// fallback teams replaces the last regular pick; without any senior the plan fails.
+func (p *Postgres) planAssignment(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, authorTeamID int64, sel entities.ReviewerSelector, dryRun bool) (entities.AssignmentPreview, []fallbackPick, error) {
+	var plan entities.AssignmentPreview
+	policy, err := p.readTeamPolicy(ctx, tx, authorTeamID, "")
+	if err != nil {
+		return plan, nil, err
+	}
+	// A dry run reads a snapshot and never waits for concurrent assignments.
+	readFallbacks := p.readFallbackTeams
+	if dryRun {
+		readFallbacks = p.selectFallbackTeams
+	}
+	fallbacks, err := readFallbacks(ctx, tx, authorTeamID)
+	if err != nil {
+		return plan, nil, err
+	}
+	policy.FallbackTeams = teamNames(fallbacks)
+	// The pool of every other team already covers the fallback teams and the code owners.
+	useFallbacks := !policy.ExcludeAuthorTeam && len(fallbacks) > 0
+	if !dryRun {
+		if err := p.lockAssignmentPlan(ctx, tx, policy, authorTeamID, fallbacks, pr.CodeOwners); err != nil {
+			return plan, nil, err
+		}
+	}
+	pairing, err := p.readAuthorPairing(ctx, tx, pr.AuthorID)
+	if err != nil {
+		return plan, nil, err
+	}
+	required, err := p.readRequiredCandidates(ctx, tx, pairing.required)
+	if err != nil {
+		return plan, nil, err
+	}
+	exclude := pairing.exclude()
+	candidates, err := p.selectAssignmentPool(ctx, tx, policy, authorTeamID, pr.AuthorID, exclude)
+	if err != nil {
+		return plan, nil, err
+	}
+	owners, err := p.readOwnerCandidates(ctx, tx, pr.CodeOwners, policy, authorTeamID, pr.AuthorID, exclude)
+	if err != nil {
+		return plan, nil, err
+	}
+
+	plan.Policy = policy
+	plan.Required = required
+	plan.CodeOwners = owners
+	plan.Candidates = candidates
+	plan.Reviewers = append([]string(nil), pairing.required...)
+	needSenior := policy.RequireSenior && !anySenior(pairing.required, required)
+	req := entities.SelectionRequest{
+		Mode:          policy.SelectionMode,
+		Preferred:     owners,
+		Candidates:    candidates,
+		Labels:        pr.Labels,
+		Count:         policy.ReviewerCount - len(required),
+		DryRun:        dryRun,
+		RequireSenior: needSenior,
+	}
+	if needSenior && req.Count < 1 {
+		// Required reviewers took every slot, so the senior comes on top of them.
+		req.Count = 1
+	}
+	if req.Count > 0 {
+		plan.Reviewers = append(plan.Reviewers, sel.Select(req)...)
+	}
+	picked := exclude
+	for _, r := range plan.Reviewers {
+		picked[r] = struct{}{}
+	}
+	req.Preferred = nil
+	req.RequireSenior = false
+
+	var picks []fallbackPick
+	if needSenior && !anySenior(plan.Reviewers, owners, candidates) {
+		if useFallbacks {
+			seniorReq := req
+			seniorReq.Count = 1
+			if picks, err = p.topUpFromFallbacks(ctx, tx, sel, seniorReq, fallbacks, authorTeamID, pr.AuthorID, picked, isSenior); err != nil {
+				return plan, nil, err
+			}
+		}
+		if len(picks) == 0 {
+			p.log.Errorw("no senior reviewer available", "pr_id", pr.ID, "author_id", pr.AuthorID)
+			return plan, nil, fmt.Errorf("%w: no senior reviewer available for PRs of %s", entities.ErrSeniorRequired, pr.AuthorID)
+		}
+		// The senior from a fallback team takes the place of the last regular pick.
+		if n := len(plan.Reviewers); n >= policy.ReviewerCount && n > len(required) {
+			plan.Reviewers = plan.Reviewers[:n-1]
+		}
+		plan.Reviewers = append(plan.Reviewers, picks[0].userID)
+	}
+
+	if missing := policy.ReviewerCount - len(plan.Reviewers); useFallbacks && missing > 0 {
+		req.Count = missing
+		more, err := p.topUpFromFallbacks(ctx, tx, sel, req, fallbacks, authorTeamID, pr.AuthorID, picked, nil)
+		if err != nil {
+			return plan, nil, err
+		}
+		for _, pk := range more {
+			plan.Reviewers = append(plan.Reviewers, pk.userID)
+		}
+		picks = append(picks, more...)
+	}
+	plan.FallbackTeams = fallbackTeamNames(picks)
+	return plan, picks, nil
+}
+
 func (p *Postgres) readReviewers(ctx context.Context, tx pgx.Tx, prID string) ([]string, error) {
 	rows, err := tx.Query(ctx, selectReviewersQuery, prID)
 	if err != nil {
@@ -280,8 +529,112 @@ func (p *Postgres) readReviewers(ctx context.Context, tx pgx.Tx, prID string) ([
 	return revs, nil
 }
 
-func (p *Postgres) insertReassignmentHistory(ctx context.Context, tx pgx.Tx, prID, oldReviewer string, newReviewer *string) error {
-	if _, err := tx.Exec(ctx, `INSERT INTO pr_reassignment_history(pr_id, old_reviewer_id, new_reviewer_id) VALUES ($1,$2,$3)`, prID, oldReviewer, newReviewer); err != nil {
+// lockAssignmentPlan locks every team a new PR of authorTeamID can draw reviewers from:
+// every other team when the policy excludes the author team, otherwise the author team,
+// its fallback subtrees and the teams of the code owners.
+func (p *Postgres) lockAssignmentPlan(ctx context.Context, tx pgx.Tx, policy entities.TeamPolicy, authorTeamID int64, fallbacks []teamRef, owners []entities.Owner) error {
+	if policy.ExcludeAuthorTeam {
+		return p.lockOtherTeamAssignments(ctx, tx, authorTeamID)
+	}
+	ownerTeams, err := p.readOwnerTeamIDs(ctx, tx, owners)
+	if err != nil {
+		return err
+	}
+	return p.lockAssignmentScope(ctx, tx, authorTeamID, fallbacks, ownerTeams...)
+}
+
+// readAssignmentPool locks and loads reviewer candidates for a PR authored in authorTeamID:
+// active members of that team, or of every other team when the policy excludes the author team.
+func (p *Postgres) readAssignmentPool(ctx context.Context, tx pgx.Tx, policy entities.TeamPolicy, authorTeamID int64, authorID string, exclude map[string]struct{}) ([]entities.Candidate, error) {
+	var err error
+	if policy.ExcludeAuthorTeam {
+		err = p.lockOtherTeamAssignments(ctx, tx, authorTeamID)
+	} else {
+		err = p.lockTeamAssignments(ctx, tx, authorTeamID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.selectAssignmentPool(ctx, tx, policy, authorTeamID, authorID, exclude)
+}
+
+// selectAssignmentPool is readAssignmentPool without the locks.
+func (p *Postgres) selectAssignmentPool(ctx context.Context, tx pgx.Tx, policy entities.TeamPolicy, authorTeamID int64, authorID string, exclude map[string]struct{}) ([]entities.Candidate, error) {
+	if !policy.ExcludeAuthorTeam {
+		return p.readCandidates(ctx, tx, selectCandidatesQuery, exclude, authorTeamID, authorID)
+	}
+	return p.readCandidates(ctx, tx, selectOtherTeamCandidatesQuery, exclude, authorTeamID, authorID)
+}
+
+// readTeamCandidates locks and loads active members of a team other than the author.
+func (p *Postgres) readTeamCandidates(ctx context.Context, tx pgx.Tx, teamID int64, authorID string, exclude map[string]struct{}) ([]entities.Candidate, error) {
+	if err := p.lockTeamAssignments(ctx, tx, teamID); err != nil {
+		return nil, err
+	}
+	return p.readCandidates(ctx, tx, selectCandidatesQuery, exclude, teamID, authorID)
+}
+
+// lockTeamAssignments serializes reviewer selection for the given teams until tx ends,
+// so concurrent assignments always observe each other's open review counts.
+// Callers locking several teams must pass ids in ascending order.
+func (p *Postgres) lockTeamAssignments(ctx context.Context, tx pgx.Tx, teamIDs ...int64) error {
+	for _, id := range teamIDs {
+		if _, err := tx.Exec(ctx, lockTeamAssignmentsQuery, assignmentLockClass, id); err != nil {
+			p.log.Errorw("failed to lock team assignments", "team_id", id, "error", err)
+			return fmt.Errorf("lock team assignments: %w", err)
+		}
+	}
+	return nil
+}
+
+// readCandidates loads rows projected by candidateColumns, skipping ids listed in exclude.
+func (p *Postgres) readCandidates(ctx context.Context, tx pgx.Tx, query string, exclude map[string]struct{}, args ...any) ([]entities.Candidate, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		p.log.Errorw("failed to select candidates", "error", err)
+		return nil, fmt.Errorf("select candidates: %w", err)
+	}
+	defer rows.Close()
+	candidates := make([]entities.Candidate, 0)
+	for rows.Next() {
+		var c entities.Candidate
+		var sched scheduleScan
+		dest := append([]any{&c.UserID, &c.AssignCnt, &c.OpenReviews, &c.Skills}, sched.dest()...)
+		if err := rows.Scan(append(dest, &c.Weight, &c.Role)...); err != nil {
+			p.log.Errorw("failed to scan candidate", "error", err)
+			return nil, err
+		}
+		c.Schedule = sched.schedule()
+		if _, ok := exclude[c.UserID]; ok {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating candidates", "error", err)
+		return nil, err
+	}
+	return candidates, nil
+}
+
+func (p *Postgres) readPRLabels(ctx context.Context, tx pgx.Tx, prID string) ([]string, error) {
+	rows, err := tx.Query(ctx, selectPRLabelsQuery, prID)
+	if err != nil {
+		p.log.Errorw("failed to select pr labels", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("select pr labels: %w", err)
+	}
+	labels, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		p.log.Errorw("failed to scan pr labels", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("scan pr labels: %w", err)
+	}
+	return labels, nil
+}
+
+// insertReassignmentHistory records a reviewer change; a nil oldReviewer marks an addition, a nil newReviewer a removal.
+// An empty reason marks a change requested by hand.
+func (p *Postgres) insertReassignmentHistory(ctx context.Context, tx pgx.Tx, prID string, oldReviewer, newReviewer *string, fallbackTeamID *int64, reason entities.ReassignReason) error {
+	if _, err := tx.Exec(ctx, `INSERT INTO pr_reassignment_history(pr_id, old_reviewer_id, new_reviewer_id, fallback_team_id, reason) VALUES ($1,$2,$3,$4,NULLIF($5::text, ''))`, prID, oldReviewer, newReviewer, fallbackTeamID, reason); err != nil {
 		return fmt.Errorf("insert reassignment history: %w", err)
 	}
 	return nil
@@ -296,22 +649,3 @@ func filterOut(list []string, target string) []string {
 	}
 	return res
 }
-
-func pickRandom(src []string, n int) []string {
-	if n >= len(src) {
-		return append([]string(nil), src...)
-	}
-	pool := append([]string(nil), src...)
-	res := make([]string, 0, n)
-	for i := 0; i < n; i++ {
-		limit := big.NewInt(int64(len(pool)))
-		idxBig, err := rand.Int(rand.Reader, limit)
-		if err != nil {
-			return pool[:n] // fallback deterministic slice
-		}
-		idx := idxBig.Int64()
-		res = append(res, pool[idx])
-		pool = append(pool[:idx], pool[idx+1:]...)
-	}
-	return res
-}
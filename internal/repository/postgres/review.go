@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	selectReviewsQuery = `
+SELECT reviewer_id, state, assigned_at, submitted_at
+FROM pr_reviewers
+WHERE pr_id=$1
+ORDER BY assigned_at, reviewer_id`
+	updateReviewStateQuery = `UPDATE pr_reviewers SET state=$3, submitted_at=NOW() WHERE pr_id=$1 AND reviewer_id=$2`
+)
+
+// SubmitReview records the decision of reviewer userID on an open PR. A declined review is handed
+// over in the same transaction to a replacement picked as ReassignReviewer does, returned as the
+// second value; when nobody fits, the declined reviewer stays assigned.
+func (p *Postgres) SubmitReview(ctx context.Context, prID, userID string, state entities.ReviewState, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockOpenPR(ctx, tx, prID)
+	if err != nil {
+		return nil, "", err
+	}
+	tag, err := tx.Exec(ctx, updateReviewStateQuery, prID, userID, state)
+	if err != nil {
+		p.log.Errorw("failed to update review state", "error", err, "pr_id", prID, "reviewer", userID)
+		return nil, "", fmt.Errorf("update review state: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		p.log.Errorw("reviewer not assigned to PR", "pr_id", prID, "reviewer", userID)
+		return nil, "", entities.ErrNotAssigned
+	}
+
+	var res *entities.PullRequest
+	var repl string
+	if state == entities.ReviewDeclined {
+		res, repl, err = p.replaceReviewer(ctx, tx, pr, userID, sel, "")
+		switch {
+		case err == nil:
+		case errors.Is(err, entities.ErrNoCandidate) || errors.Is(err, entities.ErrPairingViolation) || errors.Is(err, entities.ErrSeniorRequired):
+			// replaceReviewer fails before writing anything, so the decline alone is still committed.
+			p.log.Warnw("declined reviewer left assigned", "pr_id", prID, "reviewer", userID, "reason", err)
+		default:
+			return nil, "", err
+		}
+	}
+	if res == nil {
+		if err := p.completePR(ctx, tx, &pr); err != nil {
+			return nil, "", err
+		}
+		res = &pr
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, "", err
+	}
+
+	p.log.Infow("review submitted", "pr_id", prID, "reviewer", userID, "state", state, "replacement", repl)
+	return res, repl, nil
+}
+
+func (p *Postgres) readReviews(ctx context.Context, tx pgx.Tx, prID string) ([]entities.Review, error) {
+	rows, err := tx.Query(ctx, selectReviewsQuery, prID)
+	if err != nil {
+		p.log.Errorw("failed to select reviews", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("select reviews: %w", err)
+	}
+	reviews, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.Review, error) {
+		var r entities.Review
+		err := row.Scan(&r.ReviewerID, &r.State, &r.AssignedAt, &r.SubmittedAt)
+		return r, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan reviews", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("scan reviews: %w", err)
+	}
+	return reviews, nil
+}
@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	selectManualReviewerQuery = `SELECT team_id, is_active, role FROM users WHERE id=$1 AND team_id IS NOT NULL`
+	// selectManualEligibilityQuery reports whether a user is available now and below their review cap.
+	selectManualEligibilityQuery = `SELECT ` + availableFilter + `, ` + capacityFilter + ` FROM users u WHERE u.id=$1`
+)
+
+// manualReviewer is a user picked by hand rather than by the selector.
+type manualReviewer struct {
+	teamID int64
+	role   entities.Role
+}
+
+// AddReviewer assigns userID to an open PR on top of its current reviewers.
+func (p *Postgres) AddReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockOpenPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.readManualReviewer(ctx, tx, pr, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, insertReviewerQuery, prID, userID, nil); err != nil {
+		p.log.Errorw("failed to insert reviewer", "error", err, "pr_id", prID, "reviewer", userID)
+		return nil, fmt.Errorf("insert reviewer: %w", err)
+	}
+	if err := p.insertReassignmentHistory(ctx, tx, prID, nil, &userID, nil, ""); err != nil {
+		return nil, err
+	}
+	if err := p.publishReviewersAssigned(ctx, tx, pr, []string{userID}); err != nil {
+		return nil, err
+	}
+	pr.Reviewers = append(pr.Reviewers, userID)
+
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("reviewer added", "pr_id", prID, "reviewer", userID)
+	return &pr, nil
+}
+
+// RemoveReviewer unassigns userID from an open PR without a replacement.
+func (p *Postgres) RemoveReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockOpenPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkLeavingReviewer(ctx, tx, pr, userID); err != nil {
+		return nil, err
+	}
+	if err := p.checkSeniorLeaving(ctx, tx, pr, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, deleteReviewerQuery, prID, userID); err != nil {
+		p.log.Errorw("failed to delete reviewer", "error", err, "pr_id", prID, "reviewer", userID)
+		return nil, fmt.Errorf("delete reviewer: %w", err)
+	}
+	if err := p.insertReassignmentHistory(ctx, tx, prID, &userID, nil, nil, ""); err != nil {
+		return nil, err
+	}
+	if err := p.requestCodeHostSync(ctx, tx, prID); err != nil {
+		return nil, err
+	}
+	pr.Reviewers = filterOut(pr.Reviewers, userID)
+
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("reviewer removed", "pr_id", prID, "reviewer", userID)
+	return &pr, nil
+}
+
+// ReplaceReviewer swaps oldUserID for the explicitly chosen newUserID.
+// Unlike ReassignReviewer the new reviewer may come from any team.
+func (p *Postgres) ReplaceReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entities.PullRequest, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pr, err := p.lockOpenPR(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkLeavingReviewer(ctx, tx, pr, oldUserID); err != nil {
+		return nil, err
+	}
+	repl, err := p.readManualReviewer(ctx, tx, pr, newUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !repl.role.IsSenior() {
+		if err := p.checkSeniorLeaving(ctx, tx, pr, oldUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, deleteReviewerQuery, prID, oldUserID); err != nil {
+		return nil, fmt.Errorf("delete old reviewer: %w", err)
+	}
+	if _, err := tx.Exec(ctx, insertReviewerQuery, prID, newUserID, nil); err != nil {
+		return nil, fmt.Errorf("insert replacement: %w", err)
+	}
+	if err := p.insertReassignmentHistory(ctx, tx, prID, &oldUserID, &newUserID, nil, ""); err != nil {
+		return nil, err
+	}
+	change := entities.WebhookReviewerChange{PRID: prID, OldReviewerID: oldUserID, NewReviewerID: newUserID}
+	if err := p.publishReviewerChange(ctx, tx, pr.AuthorID, change); err != nil {
+		return nil, err
+	}
+	pr.Reviewers = append(filterOut(pr.Reviewers, oldUserID), newUserID)
+
+	if err := p.completePR(ctx, tx, &pr); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("reviewer replaced", "pr_id", prID, "old", oldUserID, "new", newUserID)
+	return &pr, nil
+}
+
+// lockOpenPR locks the PR row and loads it with its reviewers, failing on PRs that are not open.
+func (p *Postgres) lockOpenPR(ctx context.Context, tx pgx.Tx, prID string) (entities.PullRequest, error) {
+	pr, err := p.lockPR(ctx, tx, prID)
+	if err != nil {
+		return pr, err
+	}
+	switch pr.Status {
+	case entities.StatusOpen:
+		return pr, nil
+	case entities.StatusMerged:
+		return pr, entities.ErrPRMerged
+	default:
+		p.log.Errorw("PR is not open", "pr_id", prID, "status", pr.Status)
+		return pr, fmt.Errorf("%w: %s is %s", entities.ErrInvalidStatus, prID, pr.Status)
+	}
+}
+
+// readManualReviewer loads userID and checks it may be added to pr: the user exists, is active,
+// is not the author, is not assigned yet, is not blocked for the author by a pairing rule, is not
+// unavailable and is below their review cap unless a pairing rule requires them.
+// The user's team is locked like a selector pick so concurrent assignments see the new load.
+func (p *Postgres) readManualReviewer(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, userID string) (manualReviewer, error) {
+	var res manualReviewer
+	var active bool
+	if err := tx.QueryRow(ctx, selectManualReviewerQuery, userID).Scan(&res.teamID, &active, &res.role); err != nil {
+		p.log.Errorw("failed to select reviewer", "error", err, "reviewer", userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return res, entities.ErrUserNotFound
+		}
+		return res, fmt.Errorf("reviewer lookup: %w", err)
+	}
+	if userID == pr.AuthorID {
+		return res, fmt.Errorf("%w: author cannot review own PR", entities.ErrInvalidArgument)
+	}
+	if !active {
+		return res, fmt.Errorf("%w: reviewer %s is inactive", entities.ErrInvalidArgument, userID)
+	}
+	for _, r := range pr.Reviewers {
+		if r == userID {
+			p.log.Errorw("reviewer already assigned to PR", "pr_id", pr.ID, "reviewer", userID)
+			return res, entities.ErrAlreadyAssigned
+		}
+	}
+	pairing, err := p.readAuthorPairing(ctx, tx, pr.AuthorID)
+	if err != nil {
+		return res, err
+	}
+	if _, ok := pairing.blocked[userID]; ok {
+		p.log.Errorw("reviewer is blocked by a pairing rule", "pr_id", pr.ID, "reviewer", userID)
+		return res, fmt.Errorf("%w: %s is blocked for %s", entities.ErrPairingViolation, userID, pr.AuthorID)
+	}
+	if err := p.lockTeamAssignments(ctx, tx, res.teamID); err != nil {
+		return res, err
+	}
+
+	var available, belowCap bool
+	if err := tx.QueryRow(ctx, selectManualEligibilityQuery, userID).Scan(&available, &belowCap); err != nil {
+		p.log.Errorw("failed to check reviewer eligibility", "error", err, "reviewer", userID)
+		return res, fmt.Errorf("reviewer eligibility: %w", err)
+	}
+	if !available {
+		p.log.Errorw("reviewer is unavailable", "pr_id", pr.ID, "reviewer", userID)
+		return res, fmt.Errorf("%w: reviewer %s is unavailable", entities.ErrInvalidArgument, userID)
+	}
+	if !belowCap && !pairing.requires(userID) {
+		p.log.Errorw("reviewer is at capacity", "pr_id", pr.ID, "reviewer", userID)
+		return res, fmt.Errorf("%w: reviewer %s is at capacity", entities.ErrInvalidArgument, userID)
+	}
+	return res, nil
+}
+
+// checkLeavingReviewer checks userID is assigned to pr and not required for its author.
+func (p *Postgres) checkLeavingReviewer(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, userID string) error {
+	assigned := false
+	for _, r := range pr.Reviewers {
+		if r == userID {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		p.log.Errorw("reviewer not assigned to PR", "pr_id", pr.ID, "reviewer", userID)
+		return entities.ErrNotAssigned
+	}
+	pairing, err := p.readAuthorPairing(ctx, tx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	if pairing.requires(userID) {
+		p.log.Errorw("reviewer is required by a pairing rule", "pr_id", pr.ID, "reviewer", userID)
+		return fmt.Errorf("%w: %s is a required reviewer of %s", entities.ErrPairingViolation, userID, pr.AuthorID)
+	}
+	return nil
+}
+
+// checkSeniorLeaving fails with ErrSeniorRequired when the author team requires a senior reviewer
+// and userID is the last senior one of pr.
+func (p *Postgres) checkSeniorLeaving(ctx context.Context, tx pgx.Tx, pr entities.PullRequest, userID string) error {
+	var authorTeamID int64
+	if err := tx.QueryRow(ctx, selectAuthorQuery, pr.AuthorID).Scan(&authorTeamID, new(bool)); err != nil {
+		p.log.Errorw("failed to query author team", "error", err, "pr_id", pr.ID)
+		return fmt.Errorf("author lookup: %w", err)
+	}
+	policy, err := p.readTeamPolicy(ctx, tx, authorTeamID, "")
+	if err != nil || !policy.RequireSenior {
+		return err
+	}
+	var role entities.Role
+	if err := tx.QueryRow(ctx, selectReviewerTeamQuery, userID).Scan(new(int64), &role); err != nil {
+		p.log.Errorw("failed to select reviewer role", "error", err, "reviewer", userID)
+		return fmt.Errorf("reviewer lookup: %w", err)
+	}
+	if !role.IsSenior() {
+		return nil
+	}
+	hasOther, err := p.hasSeniorReviewer(ctx, tx, pr.ID, []string{userID})
+	if err != nil {
+		return err
+	}
+	if !hasOther {
+		p.log.Errorw("last senior reviewer cannot leave the PR", "pr_id", pr.ID, "reviewer", userID)
+		return fmt.Errorf("%w: %s is the last senior reviewer of %s", entities.ErrSeniorRequired, userID, pr.ID)
+	}
+	return nil
+}
+
+// completePR fills the labels, fallback teams and reviews of a PR about to be returned.
+func (p *Postgres) completePR(ctx context.Context, tx pgx.Tx, pr *entities.PullRequest) error {
+	var err error
+	if pr.Labels, err = p.readPRLabels(ctx, tx, pr.ID); err != nil {
+		return err
+	}
+	if pr.FallbackTeams, err = p.readPRFallbackTeams(ctx, tx, pr.ID); err != nil {
+		return err
+	}
+	if pr.Reviews, err = p.readReviews(ctx, tx, pr.ID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// scheduleColumns projects working hours of a users row aliased as u.
+const scheduleColumns = `u.time_zone, u.work_start_minute, u.work_end_minute, u.work_days`
+
+const updateUserScheduleQuery = `
+UPDATE users SET time_zone=$2, work_start_minute=$3, work_end_minute=$4, work_days=$5
+WHERE id=$1`
+
+// SetUserSchedule replaces working hours of a user and returns the updated domain user.
+func (p *Postgres) SetUserSchedule(ctx context.Context, userID string, schedule entities.WorkSchedule) (*entities.User, error) {
+	tag, err := p.db.Exec(ctx, updateUserScheduleQuery, userID,
+		schedule.TimeZone, schedule.StartMinute, schedule.EndMinute, weekdayMask(schedule.Days))
+	if err != nil {
+		p.log.Errorw("failed to update user schedule", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("update user schedule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, entities.ErrUserNotFound
+	}
+
+	p.log.Infow("user schedule updated", "user_id", userID, "time_zone", schedule.TimeZone)
+	return p.getUser(ctx, userID)
+}
+
+// scheduleScan receives scheduleColumns.
+type scheduleScan struct {
+	timeZone string
+	start    int16
+	end      int16
+	days     int16
+}
+
+func (s *scheduleScan) dest() []any {
+	return []any{&s.timeZone, &s.start, &s.end, &s.days}
+}
+
+func (s *scheduleScan) schedule() entities.WorkSchedule {
+	return entities.WorkSchedule{
+		TimeZone:    s.timeZone,
+		StartMinute: int(s.start),
+		EndMinute:   int(s.end),
+		Days:        weekdaysFromMask(s.days),
+	}
+}
+
+func weekdayMask(days []time.Weekday) int16 {
+	var mask int16
+	for _, d := range days {
+		mask |= 1 << d
+	}
+	return mask
+}
+
+func weekdaysFromMask(mask int16) []time.Weekday {
+	days := make([]time.Weekday, 0, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if mask&(1<<d) != 0 {
+			days = append(days, d)
+		}
+	}
+	return days
+}
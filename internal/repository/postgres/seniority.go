@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const hasSeniorReviewerQuery = `
+SELECT EXISTS (
+    SELECT 1 FROM pr_reviewers r
+    JOIN users u ON u.id = r.reviewer_id
+    WHERE r.pr_id = $1 AND u.role IN ('senior', 'lead') AND NOT (r.reviewer_id = ANY($2::text[]))
+)`
+
+// hasSeniorReviewer reports whether a reviewer of prID other than those leaving is senior or above.
+func (p *Postgres) hasSeniorReviewer(ctx context.Context, tx pgx.Tx, prID string, leaving []string) (bool, error) {
+	var ok bool
+	if err := tx.QueryRow(ctx, hasSeniorReviewerQuery, prID, leaving).Scan(&ok); err != nil {
+		p.log.Errorw("failed to check senior reviewers", "error", err, "pr_id", prID)
+		return false, fmt.Errorf("check senior reviewers: %w", err)
+	}
+	return ok, nil
+}
+
+func isSenior(c entities.Candidate) bool {
+	return c.Role.IsSenior()
+}
+
+// anySenior reports whether one of ids belongs to a senior-or-above candidate of the given pools.
+func anySenior(ids []string, pools ...[]entities.Candidate) bool {
+	want := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		want[id] = struct{}{}
+	}
+	for _, pool := range pools {
+		for _, c := range pool {
+			if _, ok := want[c.UserID]; ok && isSenior(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func filterPool(src []entities.Candidate, keep func(entities.Candidate) bool) []entities.Candidate {
+	res := make([]entities.Candidate, 0, len(src))
+	for _, c := range src {
+		if keep(c) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// waitingSince is when a reviewer started waiting on the PR: its assignment, or the moment
+// the PR was last opened, whichever is later.
+const waitingSince = `GREATEST(r.assigned_at, pr.ready_at)`
+
+const (
+	selectStaleReviewsQuery = `
+SELECT r.pr_id, r.reviewer_id, ` + waitingSince + ` AS since
+FROM pr_reviewers r
+JOIN pull_requests pr ON pr.id = r.pr_id
+WHERE pr.status='OPEN' AND r.state='pending'
+  AND ` + waitingSince + ` < NOW() - make_interval(secs => $1)
+ORDER BY since, r.pr_id, r.reviewer_id`
+	reviewIsStaleQuery = `
+SELECT EXISTS (
+    SELECT 1
+    FROM pr_reviewers r
+    JOIN pull_requests pr ON pr.id = r.pr_id
+    WHERE r.pr_id=$1 AND r.reviewer_id=$2 AND r.state='pending'
+      AND ` + waitingSince + ` < NOW() - make_interval(secs => $3)
+)`
+)
+
+// ListStaleReviews returns pending reviews on open PRs waiting longer than sla, oldest first.
+func (p *Postgres) ListStaleReviews(ctx context.Context, sla time.Duration) ([]entities.StaleReview, error) {
+	rows, err := p.db.Query(ctx, selectStaleReviewsQuery, sla.Seconds())
+	if err != nil {
+		p.log.Errorw("failed to select stale reviews", "error", err, "sla", sla)
+		return nil, fmt.Errorf("select stale reviews: %w", err)
+	}
+	reviews, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.StaleReview, error) {
+		var r entities.StaleReview
+		err := row.Scan(&r.PRID, &r.ReviewerID, &r.WaitingSince)
+		return r, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan stale reviews", "error", err)
+		return nil, fmt.Errorf("scan stale reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// ReassignStaleReview hands a review waiting longer than sla over like ReassignReviewer and
+// records the change as sla_expired. It fails with ErrReviewNotStale when the review was
+// submitted or its reviewer reassigned in the meantime.
+func (p *Postgres) ReassignStaleReview(ctx context.Context, prID, userID string, sla time.Duration, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	return p.reassignReviewer(ctx, prID, userID, sel, sla)
+}
+
+// checkReviewStale rechecks under the PR lock that the review of userID still waits longer than sla.
+func (p *Postgres) checkReviewStale(ctx context.Context, tx pgx.Tx, prID, userID string, sla time.Duration) error {
+	var stale bool
+	if err := tx.QueryRow(ctx, reviewIsStaleQuery, prID, userID, sla.Seconds()).Scan(&stale); err != nil {
+		p.log.Errorw("failed to check review staleness", "error", err, "pr_id", prID, "reviewer", userID)
+		return fmt.Errorf("check review staleness: %w", err)
+	}
+	if !stale {
+		return fmt.Errorf("%w: review of %s on %s", entities.ErrReviewNotStale, userID, prID)
+	}
+	return nil
+}
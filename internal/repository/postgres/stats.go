@@ -15,13 +15,13 @@ import (
 )
 
 const (
-	statsByUserQuery    = `SELECT reviewer_id, COUNT(*) FROM pr_reviewers GROUP BY reviewer_id`
-	statsByPRQuery      = `SELECT pr_id, COUNT(*) FROM pr_reviewers GROUP BY pr_id`
-	statsByStatusQuery  = `SELECT status, COUNT(*) FROM pull_requests GROUP BY status`
-	statsByTeamQuery    = `SELECT t.name, COUNT(*) FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id JOIN teams t ON t.id = u.team_id GROUP BY t.name`
-	reviewerExistsQuery = `SELECT true FROM users WHERE id=$1`
-	reviewerAssigns     = `SELECT COUNT(*) FROM pr_reviewers WHERE reviewer_id=$1`
-	reviewerStatus      = `
+	statsByUserQuery      = `SELECT reviewer_id, COUNT(*) FROM pr_reviewers GROUP BY reviewer_id`
+	statsByPRQuery        = `SELECT pr_id, COUNT(*) FROM pr_reviewers GROUP BY pr_id`
+	statsByStatusQuery    = `SELECT status, COUNT(*) FROM pull_requests GROUP BY status`
+	statsByTeamQuery      = `SELECT t.name, COUNT(*) FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id JOIN teams t ON t.id = u.team_id GROUP BY t.name`
+	reviewerSettingsQuery = `SELECT ` + scheduleColumns + `, ` + capacityColumns + ` FROM users u WHERE u.id=$1`
+	reviewerAssigns       = `SELECT COUNT(*) FROM pr_reviewers WHERE reviewer_id=$1`
+	reviewerStatus        = `
 SELECT pr.status, COUNT(*)
 FROM pr_reviewers r
 JOIN pull_requests pr ON pr.id = r.pr_id
@@ -36,9 +36,21 @@ ORDER BY pr.created_at DESC
 LIMIT $2`
 	prStatsQuery     = `SELECT id, name, author_id, status, created_at, merged_at FROM pull_requests WHERE id=$1`
 	prReviewersQuery = `SELECT reviewer_id FROM pr_reviewers WHERE pr_id=$1`
-	prHistoryQuery   = `SELECT old_reviewer_id, new_reviewer_id, changed_at FROM pr_reassignment_history WHERE pr_id=$1 ORDER BY changed_at DESC`
+	prHistoryQuery   = `
+SELECT h.old_reviewer_id, h.new_reviewer_id, t.name, h.reason, h.changed_at
+FROM pr_reassignment_history h
+LEFT JOIN teams t ON t.id = h.fallback_team_id
+WHERE h.pr_id=$1
+ORDER BY h.changed_at DESC`
 )
 
+// teamTreeCTE pairs every team with itself and each of its descendants.
+const teamTreeCTE = `WITH RECURSIVE team_tree AS (
+    SELECT id AS ancestor_id, id AS team_id FROM teams
+    UNION
+    SELECT tree.ancestor_id, c.id FROM teams c JOIN team_tree tree ON c.parent_id = tree.team_id
+) `
+
 // Stats returns assignments grouped by user and PR.
 func (p *Postgres) Stats(ctx context.Context) (entities.Stats, error) {
 	res := entities.Stats{}
@@ -90,6 +102,7 @@ func (p *Postgres) Stats(ctx context.Context) (entities.Stats, error) {
 	if err := rows3.Err(); err != nil {
 		return res, fmt.Errorf("iterate status stat: %w", err)
 	}
+	res.ByStatus = withAllStatuses(res.ByStatus)
 
 	rows4, err := p.db.Query(ctx, statsByTeamQuery)
 	if err != nil {
@@ -172,9 +185,16 @@ func (p *Postgres) StatsSummary(ctx context.Context, filter entities.StatsFilter
 	if err := rowsStatus.Err(); err != nil {
 		return res, fmt.Errorf("iterate status summary: %w", err)
 	}
+	res.PRStatusCounts = withAllStatuses(res.PRStatusCounts)
 
 	teamQuery := strings.Builder{}
-	teamQuery.WriteString("SELECT t.name, COUNT(*) AS assign_cnt FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id JOIN teams t ON t.id = u.team_id JOIN pull_requests pr ON pr.id = r.pr_id")
+	if filter.Rollup {
+		// Each assignment counts towards the reviewer's team and every team above it.
+		teamQuery.WriteString(teamTreeCTE)
+		teamQuery.WriteString("SELECT t.name, COUNT(*) AS assign_cnt FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id JOIN team_tree tree ON tree.team_id = u.team_id JOIN teams t ON t.id = tree.ancestor_id JOIN pull_requests pr ON pr.id = r.pr_id")
+	} else {
+		teamQuery.WriteString("SELECT t.name, COUNT(*) AS assign_cnt FROM pr_reviewers r JOIN users u ON u.id = r.reviewer_id JOIN teams t ON t.id = u.team_id JOIN pull_requests pr ON pr.id = r.pr_id")
+	}
 	if whereClause != "" {
 		teamQuery.WriteByte(' ')
 		teamQuery.WriteString(whereClause)
@@ -202,13 +222,16 @@ func (p *Postgres) StatsSummary(ctx context.Context, filter entities.StatsFilter
 // ReviewerStats returns per-user stats.
 func (p *Postgres) ReviewerStats(ctx context.Context, userID string, limit int) (entities.ReviewerStats, error) {
 	res := entities.ReviewerStats{UserID: userID}
-	var exists bool
-	if err := p.db.QueryRow(ctx, reviewerExistsQuery, userID).Scan(&exists); err != nil {
+	var sched scheduleScan
+	var capacity capacityScan
+	if err := p.db.QueryRow(ctx, reviewerSettingsQuery, userID).Scan(append(sched.dest(), capacity.dest()...)...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return res, entities.ErrUserNotFound
 		}
 		return res, fmt.Errorf("check user: %w", err)
 	}
+	res.Schedule = sched.schedule()
+	res.Capacity = capacity.capacity()
 
 	if err := p.db.QueryRow(ctx, reviewerAssigns, userID).Scan(&res.AssignCnt); err != nil {
 		return res, fmt.Errorf("count assignments: %w", err)
@@ -230,6 +253,8 @@ func (p *Postgres) ReviewerStats(ctx context.Context, userID string, limit int)
 			res.OpenPRCnt = cnt
 		case entities.StatusMerged:
 			res.MergedPRCnt = cnt
+		case entities.StatusClosed:
+			res.ClosedPRCnt = cnt
 		}
 	}
 	if err := statusRows.Err(); err != nil {
@@ -297,14 +322,24 @@ func (p *Postgres) PRStats(ctx context.Context, prID string) (entities.PRStats,
 	defer histRows.Close()
 	for histRows.Next() {
 		var ev entities.ReassignmentEvent
-		var newReviewer sql.NullString
-		if err := histRows.Scan(&ev.OldReviewerID, &newReviewer, &ev.ChangedAt); err != nil {
+		var oldReviewer, newReviewer, fallbackTeam, reason sql.NullString
+		if err := histRows.Scan(&oldReviewer, &newReviewer, &fallbackTeam, &reason, &ev.ChangedAt); err != nil {
 			p.log.Errorw("failed to scan pr history", "error", err, "pr_id", prID)
 			return res, fmt.Errorf("scan history: %w", err)
 		}
+		if oldReviewer.Valid {
+			ev.OldReviewerID = &oldReviewer.String
+		}
 		if newReviewer.Valid {
 			ev.NewReviewerID = &newReviewer.String
 		}
+		if fallbackTeam.Valid {
+			ev.FallbackTeam = &fallbackTeam.String
+		}
+		if reason.Valid {
+			r := entities.ReassignReason(reason.String)
+			ev.Reason = &r
+		}
 		res.Reassignments = append(res.Reassignments, ev)
 	}
 	if err := histRows.Err(); err != nil {
@@ -341,3 +376,16 @@ func buildPRFilter(filter entities.StatsFilter) (string, []any) {
 
 	return "WHERE " + strings.Join(conditions, " AND "), args
 }
+
+// withAllStatuses lists counts of every PR status in lifecycle order, with zero for missing ones.
+func withAllStatuses(counts []entities.StatusStat) []entities.StatusStat {
+	byStatus := make(map[entities.PullRequestStatus]int64, len(counts))
+	for _, c := range counts {
+		byStatus[c.Status] = c.PRCount
+	}
+	res := make([]entities.StatusStat, 0, len(entities.PullRequestStatuses))
+	for _, st := range entities.PullRequestStatuses {
+		res = append(res, entities.StatusStat{Status: st, PRCount: byStatus[st]})
+	}
+	return res
+}
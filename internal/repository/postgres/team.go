@@ -13,16 +13,17 @@ import (
 )
 
 const (
-	insertTeamQuery = "INSERT INTO teams(name) VALUES($1) RETURNING id"
+	insertTeamQuery = "INSERT INTO teams(name, parent_id) VALUES($1, $2) RETURNING id"
 	upsertUserQuery = `
-INSERT INTO users(id, username, team_id, is_active)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (id) DO UPDATE SET username = EXCLUDED.username, team_id = EXCLUDED.team_id, is_active = EXCLUDED.is_active
+INSERT INTO users(id, username, team_id, is_active, role)
+VALUES ($1, $2, $3, $4, COALESCE($5, 'junior'))
+ON CONFLICT (id) DO UPDATE SET username = EXCLUDED.username, team_id = EXCLUDED.team_id, is_active = EXCLUDED.is_active,
+    role = COALESCE($5, users.role)
 `
 	selectTeamIDQuery         = "SELECT id FROM teams WHERE name=$1"
-	selectTeamMembersQuery    = "SELECT id, username, is_active FROM users WHERE team_id=$1"
+	selectTeamMembersQuery    = "SELECT id, username, is_active, role FROM users WHERE team_id=$1"
 	selectTeamIDForDeactivate = `SELECT id FROM teams WHERE name=$1`
-	deactivateUsersQuery      = `UPDATE users SET is_active=false WHERE team_id=$1 AND is_active=true RETURNING id`
+	deactivateUsersQuery      = `UPDATE users SET is_active=false WHERE team_id = ANY($1::bigint[]) AND is_active=true RETURNING id, role`
 	selectImpactedPRsQuery    = `
 SELECT pr.id, pr.author_id
 FROM pull_requests pr
@@ -32,10 +33,27 @@ WHERE pr.status='OPEN' AND EXISTS (
 	selectPRStatusQuery         = `SELECT status FROM pull_requests WHERE id=$1`
 	deleteReviewerForDeactivate = `DELETE FROM pr_reviewers WHERE pr_id=$1 AND reviewer_id=$2`
 	insertReviewerForDeactivate = `INSERT INTO pr_reviewers(pr_id, reviewer_id) VALUES ($1,$2)`
-	activeReplacementQuery      = `SELECT id FROM users WHERE is_active=true AND team_id <> $1 AND id <> $2`
+	selectOtherTeamIDsQuery     = `SELECT id FROM teams WHERE id <> $1 ORDER BY id`
+	// Assignments insert reviewers under the team lock, and their foreign key checks take FOR KEY SHARE
+	// on the user row; FOR NO KEY UPDATE does not conflict with it, so a membership change holding the
+	// row and waiting for the team lock cannot deadlock with them.
+	selectMemberForUpdateQuery = `
+SELECT u.team_id, COALESCE(t.name, ''), u.role
+FROM users u
+LEFT JOIN teams t ON t.id = u.team_id
+WHERE u.id=$1
+FOR NO KEY UPDATE OF u`
+	selectAuthorsOpenPRsQuery = `SELECT EXISTS (SELECT 1 FROM pull_requests WHERE author_id=$1 AND status IN ('OPEN', 'DRAFT'))`
+	removeMemberQuery         = `UPDATE users SET team_id=NULL, is_active=false WHERE id=$1`
+	renameTeamQuery           = `UPDATE teams SET name=$2 WHERE name=$1`
+	selectTeamQuery           = `
+SELECT t.id, COALESCE(parent.name, '')
+FROM teams t
+LEFT JOIN teams parent ON parent.id = t.parent_id
+WHERE t.name=$1`
 )
 
-// CreateTeam inserts a team and upserts its members.
+// CreateTeam inserts a team under its parent, if any, and upserts its members.
 func (p *Postgres) CreateTeam(ctx context.Context, team entities.Team) (res *entities.Team, err error) {
 	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -43,8 +61,20 @@ func (p *Postgres) CreateTeam(ctx context.Context, team entities.Team) (res *ent
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
+	var parentID *int64
+	if team.Parent != "" {
+		id, err := p.teamID(ctx, tx, team.Parent)
+		if err != nil {
+			if errors.Is(err, entities.ErrTeamNotFound) {
+				return nil, fmt.Errorf("%w: parent team %s", entities.ErrTeamNotFound, team.Parent)
+			}
+			return nil, err
+		}
+		parentID = &id
+	}
+
 	var teamID int64
-	if err := tx.QueryRow(ctx, insertTeamQuery, team.Name).Scan(&teamID); err != nil {
+	if err := tx.QueryRow(ctx, insertTeamQuery, team.Name, parentID).Scan(&teamID); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			p.log.Errorw("team already exists", "team", team.Name)
@@ -58,7 +88,20 @@ func (p *Postgres) CreateTeam(ctx context.Context, team entities.Team) (res *ent
 	}
 
 	for _, m := range team.Members {
-		if _, err := tx.Exec(ctx, upsertUserQuery, m.ID, m.Username, teamID, m.IsActive); err != nil {
+		// Moving users between teams is left to AddTeamMember, which hands over their reviews.
+		cur, known, err := p.lockMember(ctx, tx, m.ID)
+		if err != nil {
+			return nil, err
+		}
+		if known && cur.teamID != nil {
+			p.log.Errorw("team member belongs to another team", "team", team.Name, "user", m.ID, "current_team", cur.teamName)
+			return nil, fmt.Errorf("%w: %s is a member of %s", entities.ErrMemberOfOtherTeam, m.ID, cur.teamName)
+		}
+		var role *entities.Role
+		if m.Role != "" {
+			role = &m.Role
+		}
+		if _, err := tx.Exec(ctx, upsertUserQuery, m.ID, m.Username, teamID, m.IsActive, role); err != nil {
 			p.log.Errorw("failed to upsert user", "user", m.ID, "error", err)
 			return nil, fmt.Errorf("upsert user: %w", err)
 		}
@@ -69,14 +112,15 @@ func (p *Postgres) CreateTeam(ctx context.Context, team entities.Team) (res *ent
 		return nil, err
 	}
 
-	p.log.Infow("team created", "team", team.Name, "members", len(team.Members))
+	p.log.Infow("team created", "team", team.Name, "parent", team.Parent, "members", len(team.Members))
 	return p.GetTeam(ctx, team.Name)
 }
 
-// GetTeam fetches team with members by name.
+// GetTeam fetches team with its parent and members by name.
 func (p *Postgres) GetTeam(ctx context.Context, name string) (team *entities.Team, err error) {
 	var teamID int64
-	if err := p.db.QueryRow(ctx, selectTeamIDQuery, name).Scan(&teamID); err != nil {
+	var parent string
+	if err := p.db.QueryRow(ctx, selectTeamQuery, name).Scan(&teamID, &parent); err != nil {
 		p.log.Errorw("failed to get team id", "team", name, "error", err)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, entities.ErrTeamNotFound
@@ -94,7 +138,7 @@ func (p *Postgres) GetTeam(ctx context.Context, name string) (team *entities.Tea
 	members := make([]entities.User, 0)
 	for rows.Next() {
 		var u entities.User
-		if err := rows.Scan(&u.ID, &u.Username, &u.IsActive); err != nil {
+		if err := rows.Scan(&u.ID, &u.Username, &u.IsActive, &u.Role); err != nil {
 			p.log.Errorw("failed to scan team member", "team", name, "error", err)
 			return nil, fmt.Errorf("scan members: %w", err)
 		}
@@ -107,11 +151,160 @@ func (p *Postgres) GetTeam(ctx context.Context, name string) (team *entities.Tea
 		return nil, fmt.Errorf("iterate members: %w", err)
 	}
 
-	return &entities.Team{Name: name, Members: members}, nil
+	return &entities.Team{Name: name, Members: members, Parent: parent}, nil
+}
+
+// AddTeamMember adds a user to a team, creating the user when unknown or updating them when
+// already a member. A member of another team is moved only when force is set; their open reviews
+// are then handed over to the rest of the team they leave.
+func (p *Postgres) AddTeamMember(ctx context.Context, teamName string, member entities.User, force bool, sel entities.ReviewerSelector) (res entities.MembershipResult, err error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return res, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	teamID, err := p.teamID(ctx, tx, teamName)
+	if err != nil {
+		return res, err
+	}
+	cur, known, err := p.lockMember(ctx, tx, member.ID)
+	if err != nil {
+		return res, err
+	}
+	moving := known && cur.teamID != nil && *cur.teamID != teamID
+	if moving && !force {
+		p.log.Errorw("team member belongs to another team", "team", teamName, "user", member.ID, "current_team", cur.teamName)
+		return res, fmt.Errorf("%w: %s is a member of %s", entities.ErrMemberOfOtherTeam, member.ID, cur.teamName)
+	}
+
+	var role *entities.Role
+	if member.Role != "" {
+		role = &member.Role
+	}
+	if _, err := tx.Exec(ctx, upsertUserQuery, member.ID, member.Username, teamID, member.IsActive, role); err != nil {
+		p.log.Errorw("failed to upsert user", "user", member.ID, "error", err)
+		return res, fmt.Errorf("upsert user: %w", err)
+	}
+
+	res.UserID = member.ID
+	if moving {
+		res.FromTeam = cur.teamName
+		if role == nil {
+			role = &cur.role
+		}
+		seniors := make(map[string]struct{})
+		if role.IsSenior() {
+			seniors[member.ID] = struct{}{}
+		}
+		res.Reassigned, res.Removed, err = p.releaseReviews(ctx, tx, sel, reviewHandover{teamID: *cur.teamID}, []string{member.ID}, seniors)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		p.log.Errorw("failed to commit team member addition", "team", teamName, "user", member.ID, "error", err)
+		return res, err
+	}
+
+	p.log.Infow("team member added", "team", teamName, "user", member.ID, "from_team", res.FromTeam, "reassigned", res.Reassigned, "removed", res.Removed)
+	team, err := p.GetTeam(ctx, teamName)
+	if err != nil {
+		return res, err
+	}
+	res.Team = *team
+	return res, nil
 }
 
-// DeactivateTeam bulk deactivates team users and reassigns their open PRs to active users from other teams.
-func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res entities.DeactivateResult, err error) {
+// RemoveTeamMember takes a user out of a team and deactivates them, handing their open reviews
+// over to the rest of the team. Members still authoring open or draft PRs cannot be removed,
+// as reviewer assignment of those PRs depends on the author team.
+func (p *Postgres) RemoveTeamMember(ctx context.Context, teamName, userID string, sel entities.ReviewerSelector) (res entities.MembershipResult, err error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return res, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	teamID, err := p.teamID(ctx, tx, teamName)
+	if err != nil {
+		return res, err
+	}
+	cur, known, err := p.lockMember(ctx, tx, userID)
+	if err != nil {
+		return res, err
+	}
+	if !known {
+		p.log.Errorw("team member to remove not found", "team", teamName, "user", userID)
+		return res, entities.ErrUserNotFound
+	}
+	if cur.teamID == nil || *cur.teamID != teamID {
+		p.log.Errorw("user to remove is not a team member", "team", teamName, "user", userID, "current_team", cur.teamName)
+		return res, fmt.Errorf("%w: %s is not a member of %s", entities.ErrNotTeamMember, userID, teamName)
+	}
+	var authorsOpen bool
+	if err := tx.QueryRow(ctx, selectAuthorsOpenPRsQuery, userID).Scan(&authorsOpen); err != nil {
+		p.log.Errorw("failed to check open PRs of team member", "user", userID, "error", err)
+		return res, fmt.Errorf("check authored prs: %w", err)
+	}
+	if authorsOpen {
+		p.log.Errorw("team member authors open PRs", "team", teamName, "user", userID)
+		return res, fmt.Errorf("%w: %s must close or merge them, or be moved to another team", entities.ErrAuthorHasOpenPRs, userID)
+	}
+
+	if _, err := tx.Exec(ctx, removeMemberQuery, userID); err != nil {
+		p.log.Errorw("failed to remove team member", "team", teamName, "user", userID, "error", err)
+		return res, fmt.Errorf("remove member: %w", err)
+	}
+	seniors := make(map[string]struct{})
+	if cur.role.IsSenior() {
+		seniors[userID] = struct{}{}
+	}
+	res.UserID, res.FromTeam = userID, teamName
+	res.Reassigned, res.Removed, err = p.releaseReviews(ctx, tx, sel, reviewHandover{teamID: teamID}, []string{userID}, seniors)
+	if err != nil {
+		return res, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		p.log.Errorw("failed to commit team member removal", "team", teamName, "user", userID, "error", err)
+		return res, err
+	}
+
+	p.log.Infow("team member removed", "team", teamName, "user", userID, "reassigned", res.Reassigned, "removed", res.Removed)
+	team, err := p.GetTeam(ctx, teamName)
+	if err != nil {
+		return res, err
+	}
+	res.Team = *team
+	return res, nil
+}
+
+// RenameTeam changes the name of a team; everything else refers to the team by id and follows.
+func (p *Postgres) RenameTeam(ctx context.Context, name, newName string) (*entities.Team, error) {
+	tag, err := p.db.Exec(ctx, renameTeamQuery, name, newName)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			p.log.Errorw("team already exists", "team", newName)
+			return nil, entities.ErrTeamExists
+		}
+		p.log.Errorw("failed to rename team", "team", name, "new_name", newName, "error", err)
+		return nil, fmt.Errorf("rename team: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		p.log.Errorw("team to rename not found", "team", name)
+		return nil, entities.ErrTeamNotFound
+	}
+
+	p.log.Infow("team renamed", "team", name, "new_name", newName)
+	return p.GetTeam(ctx, newName)
+}
+
+// DeactivateTeam bulk deactivates team users, together with the users of every team below it when
+// withDescendants is set, and reassigns their open PRs to active users from other teams.
+func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string, withDescendants bool, sel entities.ReviewerSelector) (res entities.DeactivateResult, err error) {
 	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return res, err
@@ -126,21 +319,32 @@ func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res ent
 		}
 		return res, fmt.Errorf("team lookup: %w", err)
 	}
+	teamIDs := []int64{teamID}
+	if withDescendants {
+		if teamIDs, err = p.readSubtreeIDs(ctx, tx, teamID); err != nil {
+			return res, err
+		}
+	}
 
-	rows, err := tx.Query(ctx, deactivateUsersQuery, teamID)
+	rows, err := tx.Query(ctx, deactivateUsersQuery, teamIDs)
 	if err != nil {
 		p.log.Errorw("failed to deactivate users", "team", teamName, "error", err)
 		return res, fmt.Errorf("deactivate users: %w", err)
 	}
 	defer rows.Close()
 	deactivated := make([]string, 0)
+	seniors := make(map[string]struct{})
 	for rows.Next() {
 		var id string
-		if err := rows.Scan(&id); err != nil {
+		var role entities.Role
+		if err := rows.Scan(&id, &role); err != nil {
 			p.log.Errorw("failed to scan deactivated user", "team", teamName, "error", err)
 			return res, err
 		}
 		deactivated = append(deactivated, id)
+		if role.IsSenior() {
+			seniors[id] = struct{}{}
+		}
 	}
 	if err := rows.Err(); err != nil {
 		p.log.Errorw("error iterating deactivated users", "team", teamName, "error", err)
@@ -149,6 +353,9 @@ func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res ent
 	res.DeactivatedUsers = len(deactivated)
 
 	if len(deactivated) == 0 {
+		if err := p.publishTeamDeactivated(ctx, tx, teamName, deactivated, res); err != nil {
+			return res, err
+		}
 		if err := tx.Commit(ctx); err != nil {
 			p.log.Errorw("failed to commit deactivation with no users", "team", teamName, "error", err)
 			return res, err
@@ -156,10 +363,88 @@ func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res ent
 		return res, nil
 	}
 
-	prRows, err := tx.Query(ctx, selectImpactedPRsQuery, deactivated)
+	res.Reassigned, res.Removed, err = p.releaseReviews(ctx, tx, sel, reviewHandover{teamID: teamID, otherTeams: true}, deactivated, seniors)
+	if err != nil {
+		return res, err
+	}
+	if err := p.publishTeamDeactivated(ctx, tx, teamName, deactivated, res); err != nil {
+		return res, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		p.log.Errorw("failed to commit team deactivation", "team", teamName, "error", err)
+		return res, err
+	}
+
+	p.log.Infow("team deactivated", "team", teamName, "with_descendants", withDescendants, "deactivated_users", res.DeactivatedUsers, "reassigned", res.Reassigned, "removed", res.Removed)
+	return res, nil
+}
+
+func contains(list []string, target string) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// lockOtherTeamAssignments locks reviewer selection in every team except the excluded one.
+func (p *Postgres) lockOtherTeamAssignments(ctx context.Context, tx pgx.Tx, excludedTeamID int64) error {
+	rows, err := tx.Query(ctx, selectOtherTeamIDsQuery, excludedTeamID)
+	if err != nil {
+		p.log.Errorw("failed to select teams to lock", "excluded_team_id", excludedTeamID, "error", err)
+		return fmt.Errorf("select teams: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		p.log.Errorw("failed to read teams to lock", "excluded_team_id", excludedTeamID, "error", err)
+		return fmt.Errorf("read teams: %w", err)
+	}
+	return p.lockTeamAssignments(ctx, tx, ids...)
+}
+
+// pickReplacement picks the reviewer taking over a review of a leaving user from the pool h points to.
+func (p *Postgres) pickReplacement(ctx context.Context, tx pgx.Tx, sel entities.ReviewerSelector, h reviewHandover, authorID string, existing map[string]struct{}, seniorOnly bool) (string, bool, error) {
+	query := selectCandidatesQuery
+	if h.otherTeams {
+		query = selectOtherTeamCandidatesQuery
+	}
+	pool, err := p.readCandidates(ctx, tx, query, existing, h.teamID, authorID)
 	if err != nil {
-		p.log.Errorw("failed to select impacted PRs", "team", teamName, "error", err)
-		return res, fmt.Errorf("select affected prs: %w", err)
+		p.log.Errorw("failed to select replacement candidates", "team_id", h.teamID, "other_teams", h.otherTeams, "author_id", authorID, "error", err)
+		return "", false, err
+	}
+	if seniorOnly {
+		pool = filterPool(pool, isSenior)
+	}
+	picked := sel.Select(entities.SelectionRequest{Mode: h.mode, Candidates: pool, Labels: h.labels, Count: 1})
+	if len(picked) == 0 {
+		p.log.Errorw("no replacement candidates available", "team_id", h.teamID, "other_teams", h.otherTeams, "author_id", authorID)
+		return "", false, nil
+	}
+	return picked[0], true, nil
+}
+
+// reviewHandover says where the open reviews of users leaving teamID go: to the remaining
+// members of teamID, or to every other team when the whole team is deactivated.
+// mode and labels are set per PR from the author team policy and the PR labels.
+type reviewHandover struct {
+	teamID     int64
+	otherTeams bool
+	mode       entities.SelectionMode
+	labels     []string
+}
+
+// releaseReviews takes the leaving users off the open PRs they review, handing every review over
+// to a replacement picked according to h, or dropping it with a history record when nobody fits.
+// A leaving reviewer required by a pairing rule, or the last senior one without a senior
+// replacement, fails the whole change.
+func (p *Postgres) releaseReviews(ctx context.Context, tx pgx.Tx, sel entities.ReviewerSelector, h reviewHandover, leaving []string, seniors map[string]struct{}) (reassigned, removed int, err error) {
+	prRows, err := tx.Query(ctx, selectImpactedPRsQuery, leaving)
+	if err != nil {
+		p.log.Errorw("failed to select impacted PRs", "team_id", h.teamID, "error", err)
+		return 0, 0, fmt.Errorf("select affected prs: %w", err)
 	}
 	defer prRows.Close()
 
@@ -171,21 +456,32 @@ func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res ent
 	for prRows.Next() {
 		var prID, authorID string
 		if err := prRows.Scan(&prID, &authorID); err != nil {
-			p.log.Errorw("failed to scan impacted PR", "team", teamName, "error", err)
-			return res, err
+			p.log.Errorw("failed to scan impacted PR", "team_id", h.teamID, "error", err)
+			return 0, 0, err
 		}
 		impacted = append(impacted, impactedPR{id: prID, authorID: authorID})
 	}
 	if err := prRows.Err(); err != nil {
-		p.log.Errorw("error iterating impacted PRs", "team", teamName, "error", err)
-		return res, err
+		p.log.Errorw("error iterating impacted PRs", "team_id", h.teamID, "error", err)
+		return 0, 0, err
+	}
+
+	if len(impacted) > 0 {
+		if h.otherTeams {
+			err = p.lockOtherTeamAssignments(ctx, tx, h.teamID)
+		} else {
+			err = p.lockTeamAssignments(ctx, tx, h.teamID)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
 	}
 
 	for _, pr := range impacted {
 		var status string
 		if err := tx.QueryRow(ctx, selectPRStatusQuery, pr.id).Scan(&status); err != nil {
 			p.log.Errorw("failed to get PR status", "pr_id", pr.id, "error", err)
-			return res, fmt.Errorf("status check: %w", err)
+			return reassigned, removed, fmt.Errorf("status check: %w", err)
 		}
 		if status != string(entities.StatusOpen) {
 			continue
@@ -194,96 +490,130 @@ func (p *Postgres) DeactivateTeam(ctx context.Context, teamName string) (res ent
 		reviewers, err := p.readReviewers(ctx, tx, pr.id)
 		if err != nil {
 			p.log.Errorw("failed to read PR reviewers", "pr_id", pr.id, "error", err)
-			return res, err
+			return reassigned, removed, err
 		}
 
-		existing := make(map[string]struct{}, len(reviewers))
+		pairing, err := p.readAuthorPairing(ctx, tx, pr.authorID)
+		if err != nil {
+			return reassigned, removed, err
+		}
+		var authorTeamID int64
+		var authorActive bool
+		if err := tx.QueryRow(ctx, selectAuthorQuery, pr.authorID).Scan(&authorTeamID, &authorActive); err != nil {
+			p.log.Errorw("failed to query author team", "pr_id", pr.id, "error", err)
+			return reassigned, removed, fmt.Errorf("author lookup: %w", err)
+		}
+		policy, err := p.readTeamPolicy(ctx, tx, authorTeamID, "")
+		if err != nil {
+			return reassigned, removed, err
+		}
+		labels, err := p.readPRLabels(ctx, tx, pr.id)
+		if err != nil {
+			return reassigned, removed, err
+		}
+		existing := pairing.exclude()
 		for _, r := range reviewers {
 			existing[r] = struct{}{}
 		}
 
 		for _, r := range reviewers {
-			if !contains(deactivated, r) {
+			if !contains(leaving, r) {
 				continue
 			}
+			if pairing.requires(r) {
+				p.log.Errorw("leaving reviewer is required by a pairing rule", "pr_id", pr.id, "reviewer", r, "author_id", pr.authorID)
+				return reassigned, removed, fmt.Errorf("%w: %s is a required reviewer of %s", entities.ErrPairingViolation, r, pr.authorID)
+			}
 
 			if _, err := tx.Exec(ctx, deleteReviewerForDeactivate, pr.id, r); err != nil {
 				p.log.Errorw("failed to delete old reviewer from PR", "pr_id", pr.id, "old_reviewer", r, "error", err)
-				return res, fmt.Errorf("delete old reviewer: %w", err)
+				return reassigned, removed, fmt.Errorf("delete old reviewer: %w", err)
 			}
 			delete(existing, r)
 
-			candidate, ok, err := p.pickReplacement(ctx, tx, teamID, pr.authorID, existing)
+			// The last senior reviewer of a PR may only be replaced by another senior.
+			seniorOnly := false
+			if _, ok := seniors[r]; ok && policy.RequireSenior {
+				hasOther, err := p.hasSeniorReviewer(ctx, tx, pr.id, leaving)
+				if err != nil {
+					return reassigned, removed, err
+				}
+				seniorOnly = !hasOther
+			}
+
+			ph := h
+			ph.mode = policy.SelectionMode
+			if ph.labels, err = p.replacementLabels(ctx, tx, pr.id, r, labels); err != nil {
+				return reassigned, removed, err
+			}
+			candidate, ok, err := p.pickReplacement(ctx, tx, sel, ph, pr.authorID, existing, seniorOnly)
 			if err != nil {
 				p.log.Errorw("failed to pick replacement reviewer", "pr_id", pr.id, "old_reviewer", r, "error", err)
-				return res, err
+				return reassigned, removed, err
+			}
+			if !ok && seniorOnly {
+				p.log.Errorw("no senior replacement for the last senior reviewer", "pr_id", pr.id, "old_reviewer", r)
+				return reassigned, removed, fmt.Errorf("%w: %s is the last senior reviewer of %s", entities.ErrSeniorRequired, r, pr.id)
 			}
 			if !ok {
-				if err := p.insertReassignmentHistory(ctx, tx, pr.id, r, nil); err != nil {
+				if err := p.insertReassignmentHistory(ctx, tx, pr.id, &r, nil, nil, ""); err != nil {
 					p.log.Errorw("failed to log removal of reviewer without replacement", "pr_id", pr.id, "old_reviewer", r, "error", err)
-					return res, err
+					return reassigned, removed, err
+				}
+				if err := p.requestCodeHostSync(ctx, tx, pr.id); err != nil {
+					return reassigned, removed, err
 				}
-				res.Removed++
+				removed++
 				continue
 			}
 			if _, err := tx.Exec(ctx, insertReviewerForDeactivate, pr.id, candidate); err != nil {
 				p.log.Errorw("failed to insert new reviewer to PR", "pr_id", pr.id, "new_reviewer", candidate, "error", err)
-				return res, fmt.Errorf("insert replacement: %w", err)
+				return reassigned, removed, fmt.Errorf("insert replacement: %w", err)
 			}
-			if err := p.insertReassignmentHistory(ctx, tx, pr.id, r, &candidate); err != nil {
+			if err := p.insertReassignmentHistory(ctx, tx, pr.id, &r, &candidate, nil, ""); err != nil {
 				p.log.Errorw("failed to log reviewer reassignment", "pr_id", pr.id, "old_reviewer", r, "new_reviewer", candidate, "error", err)
-				return res, err
+				return reassigned, removed, err
+			}
+			change := entities.WebhookReviewerChange{PRID: pr.id, OldReviewerID: r, NewReviewerID: candidate}
+			if err := p.publishReviewerChange(ctx, tx, pr.authorID, change); err != nil {
+				return reassigned, removed, err
 			}
 			existing[candidate] = struct{}{}
-			res.Reassigned++
+			reassigned++
 		}
 	}
+	return reassigned, removed, nil
+}
 
-	if err := tx.Commit(ctx); err != nil {
-		p.log.Errorw("failed to commit team deactivation", "team", teamName, "error", err)
-		return res, err
-	}
-
-	p.log.Infow("team deactivated", "team", teamName, "deactivated_users", res.DeactivatedUsers, "reassigned", res.Reassigned, "removed", res.Removed)
-	return res, nil
+// teamMember is the current team of a locked user.
+type teamMember struct {
+	// teamID is nil for a user removed from their team.
+	teamID   *int64
+	teamName string
+	role     entities.Role
 }
 
-func contains(list []string, target string) bool {
-	for _, v := range list {
-		if v == target {
-			return true
+// lockMember locks a user row until tx ends; known is false when there is no such user.
+func (p *Postgres) lockMember(ctx context.Context, tx pgx.Tx, userID string) (m teamMember, known bool, err error) {
+	if err := tx.QueryRow(ctx, selectMemberForUpdateQuery, userID).Scan(&m.teamID, &m.teamName, &m.role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return m, false, nil
 		}
+		p.log.Errorw("failed to lock team member", "user", userID, "error", err)
+		return m, false, fmt.Errorf("lock member: %w", err)
 	}
-	return false
+	return m, true, nil
 }
 
-func (p *Postgres) pickReplacement(ctx context.Context, tx pgx.Tx, deactivatedTeamID int64, authorID string, existing map[string]struct{}) (string, bool, error) {
-	rows, err := tx.Query(ctx, activeReplacementQuery, deactivatedTeamID, authorID)
-	if err != nil {
-		p.log.Errorw("failed to select replacement candidates", "deactivated_team_id", deactivatedTeamID, "author_id", authorID, "error", err)
-		return "", false, fmt.Errorf("select candidates: %w", err)
-	}
-	defer rows.Close()
-	pool := make([]string, 0)
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			p.log.Errorw("failed to scan replacement candidate", "deactivated_team_id", deactivatedTeamID, "author_id", authorID, "error", err)
-			return "", false, err
-		}
-		if _, ok := existing[id]; ok {
-			continue
+// teamID returns the id of the team named name.
+func (p *Postgres) teamID(ctx context.Context, tx pgx.Tx, name string) (int64, error) {
+	var id int64
+	if err := tx.QueryRow(ctx, selectTeamIDQuery, name).Scan(&id); err != nil {
+		p.log.Errorw("failed to get team id", "team", name, "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, entities.ErrTeamNotFound
 		}
-		pool = append(pool, id)
-	}
-	if err := rows.Err(); err != nil {
-		p.log.Errorw("error iterating replacement candidates", "deactivated_team_id", deactivatedTeamID, "author_id", authorID, "error", err)
-		return "", false, err
-	}
-	if len(pool) == 0 {
-		p.log.Errorw("no replacement candidates available", "deactivated_team_id", deactivatedTeamID, "author_id", authorID)
-		return "", false, nil
+		return 0, fmt.Errorf("get team: %w", err)
 	}
-	candidate := pickRandom(pool, 1)[0]
-	return candidate, true, nil
+	return id, nil
 }
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	selectTeamPolicyQuery = `
+SELECT reviewer_count, selection_mode, exclude_author_team, require_senior,
+       merge_min_reviewers, merge_require_active_reviewers, merge_min_age_seconds
+FROM team_policies
+WHERE team_id=$1`
+	upsertTeamPolicyQuery = `
+INSERT INTO team_policies(team_id, reviewer_count, selection_mode, exclude_author_team, require_senior,
+                          merge_min_reviewers, merge_require_active_reviewers, merge_min_age_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (team_id) DO UPDATE SET
+    reviewer_count = EXCLUDED.reviewer_count,
+    selection_mode = EXCLUDED.selection_mode,
+    exclude_author_team = EXCLUDED.exclude_author_team,
+    require_senior = EXCLUDED.require_senior,
+    merge_min_reviewers = EXCLUDED.merge_min_reviewers,
+    merge_require_active_reviewers = EXCLUDED.merge_require_active_reviewers,
+    merge_min_age_seconds = EXCLUDED.merge_min_age_seconds,
+    updated_at = NOW()`
+)
+
+// GetTeamPolicy returns the assignment policy of a team, or the default one if none is stored.
+func (p *Postgres) GetTeamPolicy(ctx context.Context, teamName string) (*entities.TeamPolicy, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var teamID int64
+	if err := tx.QueryRow(ctx, selectTeamIDQuery, teamName).Scan(&teamID); err != nil {
+		p.log.Errorw("failed to get team id", "team", teamName, "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	policy, err := p.readTeamPolicy(ctx, tx, teamID, teamName)
+	if err != nil {
+		return nil, err
+	}
+	fallbacks, err := p.readFallbackTeams(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	policy.FallbackTeams = teamNames(fallbacks)
+	return &policy, nil
+}
+
+// SetTeamPolicy stores the assignment policy of a team together with its ordered fallback teams.
+func (p *Postgres) SetTeamPolicy(ctx context.Context, policy entities.TeamPolicy) (*entities.TeamPolicy, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var teamID int64
+	if err := tx.QueryRow(ctx, selectTeamIDQuery, policy.TeamName).Scan(&teamID); err != nil {
+		p.log.Errorw("failed to get team id", "team", policy.TeamName, "error", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	var mode *string
+	if policy.SelectionMode != "" {
+		m := string(policy.SelectionMode)
+		mode = &m
+	}
+	merge := policy.Merge
+	if _, err := tx.Exec(ctx, upsertTeamPolicyQuery, teamID, policy.ReviewerCount, mode, policy.ExcludeAuthorTeam, policy.RequireSenior,
+		merge.MinReviewers, merge.RequireActiveReviewers, int64(merge.MinAge/time.Second)); err != nil {
+		p.log.Errorw("failed to upsert team policy", "team", policy.TeamName, "error", err)
+		return nil, fmt.Errorf("upsert team policy: %w", err)
+	}
+	if err := p.replaceFallbackTeams(ctx, tx, teamID, policy.FallbackTeams); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("team policy updated", "team", policy.TeamName, "reviewer_count", policy.ReviewerCount,
+		"selection_mode", policy.SelectionMode, "exclude_author_team", policy.ExcludeAuthorTeam, "require_senior", policy.RequireSenior,
+		"fallback_teams", policy.FallbackTeams, "merge_policy", policy.Merge)
+	return &policy, nil
+}
+
+// querier is satisfied by both the pool and transactions.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func (p *Postgres) readTeamPolicy(ctx context.Context, q querier, teamID int64, teamName string) (entities.TeamPolicy, error) {
+	policy := entities.DefaultTeamPolicy(teamName)
+	var mode sql.NullString
+	var minAgeSeconds int64
+	err := q.QueryRow(ctx, selectTeamPolicyQuery, teamID).Scan(&policy.ReviewerCount, &mode, &policy.ExcludeAuthorTeam, &policy.RequireSenior,
+		&policy.Merge.MinReviewers, &policy.Merge.RequireActiveReviewers, &minAgeSeconds)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return policy, nil
+	}
+	if err != nil {
+		p.log.Errorw("failed to read team policy", "team_id", teamID, "error", err)
+		return policy, fmt.Errorf("read team policy: %w", err)
+	}
+	policy.SelectionMode = entities.SelectionMode(mode.String)
+	policy.Merge.MinAge = time.Duration(minAgeSeconds) * time.Second
+	return policy, nil
+}
@@ -9,30 +9,45 @@ import (
 	"assigning-reviewers-for-pr/internal/entities"
 )
 
+// userSkillsColumn projects sorted skills of a users row aliased as u.
+const userSkillsColumn = `ARRAY(SELECT s.skill FROM user_skills s WHERE s.user_id = u.id ORDER BY s.skill)`
+
+// userColumns projects a users row aliased as u joined with its team t, in scanUser order;
+// the team name is empty for a user removed from their team.
+const userColumns = `u.id, u.username, COALESCE(t.name, ''), u.is_active, u.role, ` + userSkillsColumn + `, ` + scheduleColumns + `, ` + capacityColumns
+
 const (
 	setUserActiveQuery = `
 WITH updated AS (
     UPDATE users u
     SET is_active = $2
     WHERE u.id = $1
-    RETURNING u.id, u.username, u.team_id, u.is_active
+    RETURNING u.*
 )
-SELECT up.id, up.username, t.name AS team_name, up.is_active
-FROM updated up
-JOIN teams t ON t.id = up.team_id
+SELECT ` + userColumns + `
+FROM updated u
+LEFT JOIN teams t ON t.id = u.team_id
 `
-	userReviewsQuery = `SELECT pr.id, pr.name, pr.author_id, pr.status
+	userReviewsQuery = `SELECT pr.id, pr.name, pr.author_id, pr.status, r.state
 FROM pr_reviewers r
 JOIN pull_requests pr ON pr.id = r.pr_id
 WHERE r.reviewer_id = $1
+  AND ($2 = '' OR EXISTS (SELECT 1 FROM pr_labels l WHERE l.pr_id = pr.id AND l.label = $2))
+  AND ($3 = '' OR r.state = $3)
 ORDER BY pr.created_at DESC`
+	selectUserQuery = `SELECT ` + userColumns + `
+FROM users u
+LEFT JOIN teams t ON t.id = u.team_id
+WHERE u.id = $1`
+	lockUserQuery         = `SELECT 1 FROM users WHERE id=$1 FOR UPDATE`
+	deleteUserSkillsQuery = `DELETE FROM user_skills WHERE user_id=$1`
+	insertUserSkillQuery  = `INSERT INTO user_skills(user_id, skill) VALUES ($1,$2) ON CONFLICT DO NOTHING`
+	updateUserRoleQuery   = `UPDATE users SET role=$2 WHERE id=$1`
 )
 
 // SetUserActive updates the is_active flag and returns the updated domain user with team name.
 func (p *Postgres) SetUserActive(ctx context.Context, userID string, isActive bool) (*entities.User, error) {
-	var u entities.User
-	err := p.db.QueryRow(ctx, setUserActiveQuery, userID, isActive).
-		Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
+	u, err := scanUser(p.db.QueryRow(ctx, setUserActiveQuery, userID, isActive))
 	if err != nil {
 		p.log.Errorw("failed to set user active", "error", err, "user_id", userID)
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -43,12 +58,69 @@ func (p *Postgres) SetUserActive(ctx context.Context, userID string, isActive bo
 	}
 
 	p.log.Infow("user active flag updated", "user_id", userID, "is_active", isActive)
-	return &u, nil
+	return u, nil
+}
+
+// SetUserSkills replaces skills of a user and returns the updated domain user.
+func (p *Postgres) SetUserSkills(ctx context.Context, userID string, skills []string) (*entities.User, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var one int
+	if err := tx.QueryRow(ctx, lockUserQuery, userID).Scan(&one); err != nil {
+		p.log.Errorw("failed to lock user", "error", err, "user_id", userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("lock user: %w", err)
+	}
+	if _, err := tx.Exec(ctx, deleteUserSkillsQuery, userID); err != nil {
+		p.log.Errorw("failed to delete user skills", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("delete user skills: %w", err)
+	}
+	for _, s := range skills {
+		if _, err := tx.Exec(ctx, insertUserSkillQuery, userID, s); err != nil {
+			p.log.Errorw("failed to insert user skill", "error", err, "user_id", userID, "skill", s)
+			return nil, fmt.Errorf("insert user skill: %w", err)
+		}
+	}
+
+	u, err := scanUser(tx.QueryRow(ctx, selectUserQuery, userID))
+	if err != nil {
+		p.log.Errorw("failed to select user", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("select user: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	p.log.Infow("user skills updated", "user_id", userID, "skills", skills)
+	return u, nil
+}
+
+// SetUserRole changes the seniority of a user and returns the updated domain user.
+func (p *Postgres) SetUserRole(ctx context.Context, userID string, role entities.Role) (*entities.User, error) {
+	tag, err := p.db.Exec(ctx, updateUserRoleQuery, userID, role)
+	if err != nil {
+		p.log.Errorw("failed to update user role", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("update user role: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, entities.ErrUserNotFound
+	}
+
+	p.log.Infow("user role updated", "user_id", userID, "role", role)
+	return p.getUser(ctx, userID)
 }
 
-// GetUserReviews returns PRs where the user is assigned as reviewer.
-func (p *Postgres) GetUserReviews(ctx context.Context, userID string) ([]entities.PullRequestShort, error) {
-	rows, err := p.db.Query(ctx, userReviewsQuery, userID)
+// GetUserReviews returns PRs where the user is assigned as reviewer, optionally only those carrying label
+// or whose review by the user is in state.
+func (p *Postgres) GetUserReviews(ctx context.Context, userID, label string, state entities.ReviewState) ([]entities.PullRequestShort, error) {
+	rows, err := p.db.Query(ctx, userReviewsQuery, userID, label, state)
 	if err != nil {
 		return nil, fmt.Errorf("get user reviews: %w", err)
 	}
@@ -57,7 +129,7 @@ func (p *Postgres) GetUserReviews(ctx context.Context, userID string) ([]entitie
 	prs := make([]entities.PullRequestShort, 0)
 	for rows.Next() {
 		var pr entities.PullRequestShort
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.ReviewState); err != nil {
 			p.log.Errorw("failed to scan user reviews", "error", err, "user_id", userID)
 			return nil, fmt.Errorf("scan user reviews: %w", err)
 		}
@@ -71,3 +143,31 @@ func (p *Postgres) GetUserReviews(ctx context.Context, userID string) ([]entitie
 
 	return prs, nil
 }
+
+func (p *Postgres) getUser(ctx context.Context, userID string) (*entities.User, error) {
+	u, err := scanUser(p.db.QueryRow(ctx, selectUserQuery, userID))
+	if err != nil {
+		p.log.Errorw("failed to select user", "error", err, "user_id", userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("select user: %w", err)
+	}
+	return u, nil
+}
+
+// scanUser reads a row projected by userColumns.
+func scanUser(row pgx.Row) (*entities.User, error) {
+	var u entities.User
+	var sched scheduleScan
+	var capacity capacityScan
+	dest := append([]any{&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.Role, &u.Skills}, sched.dest()...)
+	if err := row.Scan(append(dest, capacity.dest()...)...); err != nil {
+		return nil, err
+	}
+	schedule := sched.schedule()
+	u.Schedule = &schedule
+	c := capacity.capacity()
+	u.Capacity = &c
+	return &u, nil
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	webhookColumns      = `id, url, secret, events, created_at`
+	insertWebhookQuery  = `INSERT INTO webhook_subscriptions(url, secret, events) VALUES ($1, $2, $3) RETURNING ` + webhookColumns
+	selectWebhooksQuery = `SELECT ` + webhookColumns + ` FROM webhook_subscriptions ORDER BY id`
+	deleteWebhookQuery  = `DELETE FROM webhook_subscriptions WHERE id=$1 RETURNING ` + webhookColumns
+	deliveryColumns     = `d.id, d.subscription_id, d.event_id, e.event_type, d.status, d.attempts, d.response_code, d.last_error, d.next_attempt_at, d.last_attempt_at, d.delivered_at, d.created_at`
+	// Claimed deliveries are leased by pushing next_attempt_at forward, so a crashed
+	// dispatcher's batch is picked up again once the lease runs out.
+	claimWebhookDeliveriesQuery = `
+WITH due AS (
+    SELECT id FROM webhook_deliveries
+    WHERE status='pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at, id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+), claimed AS (
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = NOW() + make_interval(secs => $2)
+    FROM due
+    WHERE d.id = due.id
+    RETURNING d.*
+)
+SELECT ` + deliveryColumns + `, s.url, s.secret, e.payload
+FROM claimed d
+JOIN domain_events e ON e.id = d.event_id
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+ORDER BY d.id`
+	recordWebhookAttemptQuery = `
+UPDATE webhook_deliveries
+SET attempts = attempts + 1,
+    last_attempt_at = NOW(),
+    status = $2,
+    response_code = $3,
+    last_error = NULLIF($4::text, ''),
+    delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() END,
+    next_attempt_at = NOW() + make_interval(secs => $5)
+WHERE id=$1`
+)
+
+// AddWebhookSubscription stores a webhook endpoint with its event filter.
+func (p *Postgres) AddWebhookSubscription(ctx context.Context, sub entities.WebhookSubscription) (*entities.WebhookSubscription, error) {
+	events := make([]string, 0, len(sub.Events))
+	for _, e := range sub.Events {
+		events = append(events, string(e))
+	}
+	res, err := scanWebhook(p.db.QueryRow(ctx, insertWebhookQuery, sub.URL, sub.Secret, events))
+	if err != nil {
+		p.log.Errorw("failed to insert webhook", "error", err, "url", sub.URL)
+		return nil, fmt.Errorf("insert webhook: %w", err)
+	}
+
+	p.log.Infow("webhook added", "id", res.ID, "url", res.URL, "events", res.Events)
+	return res, nil
+}
+
+// ListWebhookSubscriptions returns all webhook subscriptions.
+func (p *Postgres) ListWebhookSubscriptions(ctx context.Context) ([]entities.WebhookSubscription, error) {
+	rows, err := p.db.Query(ctx, selectWebhooksQuery)
+	if err != nil {
+		p.log.Errorw("failed to select webhooks", "error", err)
+		return nil, fmt.Errorf("select webhooks: %w", err)
+	}
+	defer rows.Close()
+	res := make([]entities.WebhookSubscription, 0)
+	for rows.Next() {
+		sub, err := scanWebhook(rows)
+		if err != nil {
+			p.log.Errorw("failed to scan webhook", "error", err)
+			return nil, fmt.Errorf("scan webhook: %w", err)
+		}
+		res = append(res, *sub)
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating webhooks", "error", err)
+		return nil, fmt.Errorf("iterate webhooks: %w", err)
+	}
+	return res, nil
+}
+
+// DeleteWebhookSubscription removes a subscription together with its delivery log and returns it.
+func (p *Postgres) DeleteWebhookSubscription(ctx context.Context, id int64) (*entities.WebhookSubscription, error) {
+	res, err := scanWebhook(p.db.QueryRow(ctx, deleteWebhookQuery, id))
+	if err != nil {
+		p.log.Errorw("failed to delete webhook", "error", err, "id", id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entities.ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("delete webhook: %w", err)
+	}
+
+	p.log.Infow("webhook deleted", "id", id, "url", res.URL)
+	return res, nil
+}
+
+// ListWebhookDeliveries returns the most recent deliveries matching filter, newest first.
+func (p *Postgres) ListWebhookDeliveries(ctx context.Context, filter entities.DeliveryFilter) ([]entities.WebhookDelivery, error) {
+	var b strings.Builder
+	b.WriteString(`SELECT ` + deliveryColumns + ` FROM webhook_deliveries d JOIN domain_events e ON e.id = d.event_id`)
+	conditions := make([]string, 0, 2)
+	args := make([]any, 0, 3)
+	if filter.SubscriptionID != nil {
+		args = append(args, *filter.SubscriptionID)
+		conditions = append(conditions, "d.subscription_id = $"+strconv.Itoa(len(args)))
+	}
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		conditions = append(conditions, "d.status = $"+strconv.Itoa(len(args)))
+	}
+	if len(conditions) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, filter.Limit)
+	b.WriteString(" ORDER BY d.id DESC LIMIT $" + strconv.Itoa(len(args)))
+
+	rows, err := p.db.Query(ctx, b.String(), args...)
+	if err != nil {
+		p.log.Errorw("failed to select webhook deliveries", "error", err)
+		return nil, fmt.Errorf("select webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+	res := make([]entities.WebhookDelivery, 0)
+	for rows.Next() {
+		var d entities.WebhookDelivery
+		if err := rows.Scan(deliveryFields(&d)...); err != nil {
+			p.log.Errorw("failed to scan webhook delivery", "error", err)
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		res = append(res, d)
+	}
+	if err := rows.Err(); err != nil {
+		p.log.Errorw("error iterating webhook deliveries", "error", err)
+		return nil, fmt.Errorf("iterate webhook deliveries: %w", err)
+	}
+	return res, nil
+}
+
+// ClaimWebhookDeliveries leases up to limit due deliveries for lease and loads what is needed to send them.
+func (p *Postgres) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	rows, err := p.db.Query(ctx, claimWebhookDeliveriesQuery, limit, lease.Seconds())
+	if err != nil {
+		p.log.Errorw("failed to claim webhook deliveries", "error", err)
+		return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+	res, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.WebhookDelivery, error) {
+		var d entities.WebhookDelivery
+		err := row.Scan(append(deliveryFields(&d), &d.URL, &d.Secret, &d.Payload)...)
+		return d, err
+	})
+	if err != nil {
+		p.log.Errorw("failed to scan claimed webhook deliveries", "error", err)
+		return nil, fmt.Errorf("scan claimed webhook deliveries: %w", err)
+	}
+	return res, nil
+}
+
+// RecordWebhookAttempt stores the outcome of one delivery attempt.
+func (p *Postgres) RecordWebhookAttempt(ctx context.Context, attempt entities.WebhookAttempt) error {
+	if _, err := p.db.Exec(ctx, recordWebhookAttemptQuery, attempt.DeliveryID, attempt.Status, attempt.ResponseCode, attempt.Error, attempt.RetryIn.Seconds()); err != nil {
+		p.log.Errorw("failed to record webhook attempt", "error", err, "delivery_id", attempt.DeliveryID)
+		return fmt.Errorf("record webhook attempt: %w", err)
+	}
+	return nil
+}
+
+func scanWebhook(row pgx.Row) (*entities.WebhookSubscription, error) {
+	var sub entities.WebhookSubscription
+	var events []string
+	if err := row.Scan(&sub.ID, &sub.URL, &sub.Secret, &events, &sub.CreatedAt); err != nil {
+		return nil, err
+	}
+	sub.Events = make([]entities.WebhookEvent, 0, len(events))
+	for _, e := range events {
+		sub.Events = append(sub.Events, entities.WebhookEvent(e))
+	}
+	return &sub, nil
+}
+
+// deliveryFields lists scan targets matching deliveryColumns.
+func deliveryFields(d *entities.WebhookDelivery) []any {
+	return []any{&d.ID, &d.SubscriptionID, &d.EventID, &d.Event, &d.Status, &d.Attempts, &d.ResponseCode,
+		&d.LastError, &d.NextAttemptAt, &d.LastAttemptAt, &d.DeliveredAt, &d.CreatedAt}
+}
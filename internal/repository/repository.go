@@ -15,8 +15,14 @@ import (
 type Repository interface {
 	LifecycleInterface
 	UserInterface
+	AvailabilityInterface
 	TeamInterface
 	PullRequestInterface
+	OwnershipInterface
+	PairingInterface
+	WebhookInterface
+	EventInterface
+	CodeHostSyncInterface
 	StatsInterface
 }
 
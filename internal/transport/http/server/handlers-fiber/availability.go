@@ -0,0 +1,75 @@
+package handlers_fiber
+
+import (
+	"net/http"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PostUsersAddUnavailability stores an out-of-office period of a user.
+func (h *Handler) PostUsersAddUnavailability(c *fiber.Ctx) error {
+	var body api.PostUsersAddUnavailabilityJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	period := entities.Unavailability{
+		UserID:   body.UserId,
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+	}
+	if body.Reason != nil {
+		period.Reason = *body.Reason
+	}
+	if body.ReassignReviews != nil {
+		period.ReassignReviews = *body.ReassignReviews
+	}
+
+	created, err := h.uc.AddUnavailability(c.Context(), period)
+	if err != nil {
+		h.log.Errorw("failed to add unavailability", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(struct {
+		Period api.Unavailability `json:"period"`
+	}{Period: mapper.ToOAPIUnavailability(*created)})
+}
+
+// PostUsersDeleteUnavailability removes an out-of-office period.
+func (h *Handler) PostUsersDeleteUnavailability(c *fiber.Ctx) error {
+	var body api.PostUsersDeleteUnavailabilityJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	deleted, err := h.uc.DeleteUnavailability(c.Context(), body.Id)
+	if err != nil {
+		h.log.Errorw("failed to delete unavailability", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Period api.Unavailability `json:"period"`
+	}{Period: mapper.ToOAPIUnavailability(*deleted)})
+}
+
+// GetUsersGetUnavailability returns current and upcoming out-of-office periods of a user.
+func (h *Handler) GetUsersGetUnavailability(c *fiber.Ctx, params api.GetUsersGetUnavailabilityParams) error {
+	periods, err := h.uc.Unavailability(c.Context(), params.UserId)
+	if err != nil {
+		h.log.Errorw("failed to list unavailability", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(struct {
+		UserID  string               `json:"user_id"`
+		Periods []api.Unavailability `json:"periods"`
+	}{
+		UserID:  params.UserId,
+		Periods: mapper.ToOAPIUnavailabilityList(periods),
+	})
+}
@@ -0,0 +1,88 @@
+package handlers_fiber
+
+import (
+	"net/http"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"assigning-reviewers-for-pr/internal/usecase/codehost"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PostIntegrationsGithubWebhook applies a signed GitHub pull_request delivery.
+func (h *Handler) PostIntegrationsGithubWebhook(c *fiber.Ctx) error {
+	gh := h.hosts.GitHub
+	if gh == nil {
+		return c.Status(http.StatusNotFound).JSON(errorResponse(api.NOTFOUND, "github integration is disabled"))
+	}
+	delivery := c.Get(codehost.GitHubDeliveryHeader)
+	body := c.Body()
+	if err := gh.Verify(body, c.Get(codehost.GitHubSignatureHeader)); err != nil {
+		h.log.Errorw("rejected github delivery", "delivery", delivery, "error", err.Error())
+		return writeError(c, err)
+	}
+
+	event, ok, err := gh.Parse(c.Get(codehost.GitHubEventHeader), body)
+	return h.applyCodeHostEvent(c, "github", delivery, event, ok, err)
+}
+
+// PostIntegrationsGitlabWebhook applies a GitLab Merge Request Hook delivery carrying the configured token.
+func (h *Handler) PostIntegrationsGitlabWebhook(c *fiber.Ctx) error {
+	gl := h.hosts.GitLab
+	if gl == nil {
+		return c.Status(http.StatusNotFound).JSON(errorResponse(api.NOTFOUND, "gitlab integration is disabled"))
+	}
+	delivery := c.Get(codehost.GitLabDeliveryHeader)
+	if err := gl.Verify(c.Get(codehost.GitLabTokenHeader)); err != nil {
+		h.log.Errorw("rejected gitlab delivery", "delivery", delivery, "error", err.Error())
+		return writeError(c, err)
+	}
+
+	event, ok, err := gl.Parse(c.Get(codehost.GitLabEventHeader), c.Body())
+	return h.applyCodeHostEvent(c, "gitlab", delivery, event, ok, err)
+}
+
+// applyCodeHostEvent answers with the outcome of a parsed code host delivery.
+func (h *Handler) applyCodeHostEvent(c *fiber.Ctx, host, delivery string, event entities.CodeHostEvent, ok bool, err error) error {
+	if err != nil {
+		h.log.Errorw("failed to parse code host delivery", "host", host, "delivery", delivery, "error", err.Error())
+		return writeError(c, err)
+	}
+	if !ok {
+		return c.Status(http.StatusOK).JSON(api.CodeHostWebhookResult{Status: api.Ignored})
+	}
+	pr, err := h.uc.ApplyCodeHostEvent(c.Context(), event)
+	if err != nil {
+		h.log.Errorw("failed to apply code host delivery", "host", host, "delivery", delivery, "pr_id", event.PR.ID, "error", err.Error())
+		return writeError(c, err)
+	}
+	if pr == nil {
+		return c.Status(http.StatusOK).JSON(api.CodeHostWebhookResult{Status: api.Ignored})
+	}
+	res := mapper.ToOAPIPull(*pr)
+	return c.Status(http.StatusOK).JSON(api.CodeHostWebhookResult{Status: api.Applied, Pr: &res})
+}
+
+// GetIntegrationsSyncStatus lists the reviewer push state of PRs.
+func (h *Handler) GetIntegrationsSyncStatus(c *fiber.Ctx, params api.GetIntegrationsSyncStatusParams) error {
+	var filter entities.CodeHostSyncFilter
+	if params.PrId != nil {
+		filter.PRID = *params.PrId
+	}
+	if params.Status != nil {
+		status := entities.CodeHostSyncStatus(*params.Status)
+		filter.Status = &status
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+	syncs, err := h.uc.CodeHostSyncs(c.Context(), filter)
+	if err != nil {
+		h.log.Errorw("failed to list code host syncs", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Syncs []api.CodeHostSync `json:"syncs"`
+	}{Syncs: mapper.ToOAPICodeHostSyncs(syncs)})
+}
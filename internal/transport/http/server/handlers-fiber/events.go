@@ -0,0 +1,79 @@
+package handlers_fiber
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// heartbeatInterval is how often an idle event stream sends a comment to keep the connection open.
+const heartbeatInterval = 15 * time.Second
+
+// GetEventsStream streams domain events as Server-Sent Events, resuming after Last-Event-ID.
+func (h *Handler) GetEventsStream(c *fiber.Ctx, params api.GetEventsStreamParams) error {
+	var filter entities.EventFilter
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	var lastEventID int64
+	if params.LastEventId != nil {
+		lastEventID = *params.LastEventId
+	}
+	if header := c.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseInt(header, 10, 64)
+		if err != nil {
+			h.log.Errorw("failed to parse Last-Event-ID", "error", err.Error())
+			return writeError(c, fmt.Errorf("%w: invalid Last-Event-ID", entities.ErrInvalidArgument))
+		}
+		lastEventID = id
+	}
+
+	// The request context outlives the handler while the body is streamed and is done on server shutdown.
+	rctx := c.Context()
+	sub, err := h.uc.SubscribeEvents(rctx, filter, lastEventID)
+	if err != nil {
+		h.log.Errorw("failed to subscribe to events", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	rctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+		for {
+			// The server write timeout covers the whole response, so keep pushing it forward.
+			_ = rctx.Conn().SetWriteDeadline(time.Now().Add(2 * heartbeatInterval))
+
+			ctx, cancel := context.WithTimeout(rctx, heartbeatInterval)
+			e, err := sub.Next(ctx)
+			cancel()
+			switch {
+			case err == nil:
+				_, _ = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Payload)
+			case errors.Is(err, context.DeadlineExceeded) && rctx.Err() == nil:
+				_, _ = w.WriteString(": ping\n\n")
+			default:
+				h.log.Infow("event stream ended", "reason", err.Error())
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
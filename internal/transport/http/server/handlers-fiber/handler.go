@@ -3,19 +3,28 @@ package handlers_fiber
 
 import (
 	"assigning-reviewers-for-pr/internal/usecase"
+	"assigning-reviewers-for-pr/internal/usecase/codehost"
 	"go.uber.org/zap"
 )
 
+// CodeHosts holds the code host integrations; a nil one disables its webhook endpoint.
+type CodeHosts struct {
+	GitHub *codehost.GitHub
+	GitLab *codehost.GitLab
+}
+
 // Handler implements oapi.ServerInterface using service layer interfaces.
 type Handler struct {
-	log *zap.SugaredLogger
-	uc  usecase.InterfaceUsecase
+	log   *zap.SugaredLogger
+	uc    usecase.InterfaceUsecase
+	hosts CodeHosts
 }
 
 // NewHandler constructs an HTTP server with service dependencies.
-func NewHandler(log *zap.SugaredLogger, usecase usecase.InterfaceUsecase) *Handler {
+func NewHandler(log *zap.SugaredLogger, usecase usecase.InterfaceUsecase, hosts CodeHosts) *Handler {
 	return &Handler{
-		log: log,
-		uc:  usecase,
+		log:   log,
+		uc:    usecase,
+		hosts: hosts,
 	}
 }
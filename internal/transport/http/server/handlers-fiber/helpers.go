@@ -19,7 +19,9 @@ func writeError(c *fiber.Ctx, err error) error {
 		status = http.StatusBadRequest
 		code = api.NOTFOUND
 		msg = err.Error()
-	case errors.Is(err, entities.ErrUserNotFound), errors.Is(err, entities.ErrTeamNotFound), errors.Is(err, entities.ErrPRNotFound):
+	case errors.Is(err, entities.ErrUserNotFound), errors.Is(err, entities.ErrTeamNotFound), errors.Is(err, entities.ErrPRNotFound),
+		errors.Is(err, entities.ErrUnavailabilityNotFound), errors.Is(err, entities.ErrPairingRuleNotFound),
+		errors.Is(err, entities.ErrWebhookNotFound):
 		status = http.StatusNotFound
 		code = api.NOTFOUND
 		msg = "resource not found"
@@ -27,6 +29,18 @@ func writeError(c *fiber.Ctx, err error) error {
 		status = http.StatusBadRequest
 		code = api.TEAMEXISTS
 		msg = "team_name already exists"
+	case errors.Is(err, entities.ErrMemberOfOtherTeam):
+		status = http.StatusConflict
+		code = api.MEMBEROFOTHERTEAM
+		msg = err.Error()
+	case errors.Is(err, entities.ErrNotTeamMember):
+		status = http.StatusConflict
+		code = api.NOTTEAMMEMBER
+		msg = err.Error()
+	case errors.Is(err, entities.ErrAuthorHasOpenPRs):
+		status = http.StatusConflict
+		code = api.AUTHORHASOPENPRS
+		msg = err.Error()
 	case errors.Is(err, entities.ErrPRExists):
 		status = http.StatusConflict
 		code = api.PREXISTS
@@ -35,14 +49,46 @@ func writeError(c *fiber.Ctx, err error) error {
 		status = http.StatusConflict
 		code = api.PRMERGED
 		msg = "cannot reassign on merged PR"
+	case errors.Is(err, entities.ErrInvalidStatus):
+		status = http.StatusConflict
+		code = api.INVALIDSTATUS
+		msg = err.Error()
 	case errors.Is(err, entities.ErrNotAssigned):
 		status = http.StatusConflict
 		code = api.NOTASSIGNED
 		msg = "reviewer is not assigned to this PR"
+	case errors.Is(err, entities.ErrAlreadyAssigned):
+		status = http.StatusConflict
+		code = api.ALREADYASSIGNED
+		msg = "reviewer is already assigned to this PR"
 	case errors.Is(err, entities.ErrNoCandidate):
 		status = http.StatusConflict
 		code = api.NOCANDIDATE
 		msg = "no active replacement candidate in team"
+	case errors.Is(err, entities.ErrPairingRuleExists):
+		status = http.StatusConflict
+		code = api.PAIRINGEXISTS
+		msg = "pairing rule for this author and reviewer already exists"
+	case errors.Is(err, entities.ErrPairingViolation):
+		status = http.StatusConflict
+		code = api.PAIRINGVIOLATION
+		msg = err.Error()
+	case errors.Is(err, entities.ErrSeniorRequired):
+		status = http.StatusConflict
+		code = api.SENIORREQUIRED
+		msg = err.Error()
+	case errors.Is(err, entities.ErrMergeBlocked):
+		status = http.StatusConflict
+		code = api.MERGEBLOCKED
+		msg = err.Error()
+	case errors.Is(err, entities.ErrStreamDisabled):
+		status = http.StatusNotFound
+		code = api.NOTFOUND
+		msg = "event stream is disabled"
+	case errors.Is(err, entities.ErrInvalidSignature):
+		status = http.StatusUnauthorized
+		code = api.UNAUTHORIZED
+		msg = "invalid signature"
 	default:
 		msg = err.Error()
 	}
@@ -56,3 +102,16 @@ func errorResponse(code api.ErrorResponseErrorCode, msg string) api.ErrorRespons
 		Message string                     `json:"message"`
 	}{Code: code, Message: msg}}
 }
+
+// pageParams reads the shared cursor and limit query parameters of list endpoints.
+func pageParams(cursor *api.CursorQuery, limit *api.LimitQuery) (string, int) {
+	var c string
+	if cursor != nil {
+		c = *cursor
+	}
+	var l int
+	if limit != nil {
+		l = int(*limit)
+	}
+	return c, l
+}
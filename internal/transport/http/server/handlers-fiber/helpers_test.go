@@ -2,6 +2,7 @@ package handlers_fiber
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -72,6 +73,14 @@ func TestWriteErrorReassignConflicts(t *testing.T) {
 				Message string                     `json:"message"`
 			}{Code: api.NOTASSIGNED, Message: "reviewer is not assigned to this PR"}},
 		},
+		{
+			name: "already_assigned",
+			err:  entities.ErrAlreadyAssigned,
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.ALREADYASSIGNED, Message: "reviewer is already assigned to this PR"}},
+		},
 		{
 			name: "no_candidate",
 			err:  entities.ErrNoCandidate,
@@ -80,6 +89,62 @@ func TestWriteErrorReassignConflicts(t *testing.T) {
 				Message string                     `json:"message"`
 			}{Code: api.NOCANDIDATE, Message: "no active replacement candidate in team"}},
 		},
+		{
+			name: "pairing_violation",
+			err:  fmt.Errorf("%w: u2 is a required reviewer of u1", entities.ErrPairingViolation),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.PAIRINGVIOLATION, Message: "pairing rule cannot be satisfied: u2 is a required reviewer of u1"}},
+		},
+		{
+			name: "senior_required",
+			err:  fmt.Errorf("%w: u2 is the last senior reviewer of pr-1", entities.ErrSeniorRequired),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.SENIORREQUIRED, Message: "senior reviewer required: u2 is the last senior reviewer of pr-1"}},
+		},
+		{
+			name: "merge_blocked",
+			err:  fmt.Errorf("%w: 1 of 2 required reviewers assigned", entities.ErrMergeBlocked),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.MERGEBLOCKED, Message: "merge preconditions not met: 1 of 2 required reviewers assigned"}},
+		},
+		{
+			name: "invalid_status",
+			err:  fmt.Errorf("%w: pr-1 is CLOSED", entities.ErrInvalidStatus),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.INVALIDSTATUS, Message: "invalid pr status: pr-1 is CLOSED"}},
+		},
+		{
+			name: "member_of_other_team",
+			err:  fmt.Errorf("%w: u3 is a member of backend", entities.ErrMemberOfOtherTeam),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.MEMBEROFOTHERTEAM, Message: "user is a member of another team: u3 is a member of backend"}},
+		},
+		{
+			name: "not_team_member",
+			err:  fmt.Errorf("%w: u3 is not a member of backend", entities.ErrNotTeamMember),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.NOTTEAMMEMBER, Message: "user is not a member of the team: u3 is not a member of backend"}},
+		},
+		{
+			name: "author_has_open_prs",
+			err:  fmt.Errorf("%w: u3 must close or merge them, or be moved to another team", entities.ErrAuthorHasOpenPRs),
+			expected: api.ErrorResponse{Error: struct {
+				Code    api.ErrorResponseErrorCode `json:"code"`
+				Message string                     `json:"message"`
+			}{Code: api.AUTHORHASOPENPRS, Message: "user authors open pull requests: u3 must close or merge them, or be moved to another team"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -104,3 +169,22 @@ func TestWriteErrorReassignConflicts(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteErrorInvalidSignature(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return writeError(c, fmt.Errorf("%w: X-Hub-Signature-256 is not hex", entities.ErrInvalidSignature))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	var body api.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, api.UNAUTHORIZED, body.Error.Code)
+	require.Equal(t, "invalid signature", body.Error.Message)
+}
@@ -0,0 +1,58 @@
+package handlers_fiber
+
+import (
+	"net/http"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetOwnershipRules returns code ownership rules in evaluation order.
+func (h *Handler) GetOwnershipRules(c *fiber.Ctx) error {
+	rules, err := h.uc.OwnershipRules(c.Context())
+	if err != nil {
+		h.log.Errorw("failed to list ownership rules", "error", err.Error())
+		return writeError(c, err)
+	}
+	return writeOwnershipRules(c, rules)
+}
+
+// PostOwnershipRules replaces code ownership rules.
+func (h *Handler) PostOwnershipRules(c *fiber.Ctx) error {
+	var body api.PostOwnershipRulesJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	rules, err := h.uc.SetOwnershipRules(c.Context(), mapper.FromOAPIOwnershipRules(body.Rules))
+	if err != nil {
+		h.log.Errorw("failed to set ownership rules", "error", err.Error())
+		return writeError(c, err)
+	}
+	return writeOwnershipRules(c, rules)
+}
+
+// PostOwnershipImport replaces code ownership rules with parsed CODEOWNERS content.
+func (h *Handler) PostOwnershipImport(c *fiber.Ctx) error {
+	var body api.PostOwnershipImportJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	rules, err := h.uc.ImportCodeowners(c.Context(), body.Codeowners)
+	if err != nil {
+		h.log.Errorw("failed to import codeowners", "error", err.Error())
+		return writeError(c, err)
+	}
+	return writeOwnershipRules(c, rules)
+}
+
+func writeOwnershipRules(c *fiber.Ctx, rules []entities.OwnershipRule) error {
+	return c.Status(http.StatusOK).JSON(struct {
+		Rules []api.OwnershipRule `json:"rules"`
+	}{Rules: mapper.ToOAPIOwnershipRules(rules)})
+}
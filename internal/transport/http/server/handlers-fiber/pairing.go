@@ -0,0 +1,66 @@
+package handlers_fiber
+
+import (
+	"net/http"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PostPairingAdd stores a blocked or required author/reviewer pair.
+func (h *Handler) PostPairingAdd(c *fiber.Ctx) error {
+	var body api.PostPairingAddJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	rule, err := h.uc.AddPairingRule(c.Context(), entities.PairingRule{
+		Kind:       entities.PairingKind(body.Kind),
+		AuthorID:   body.AuthorId,
+		ReviewerID: body.ReviewerId,
+	})
+	if err != nil {
+		h.log.Errorw("failed to add pairing rule", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(struct {
+		Rule api.PairingRule `json:"rule"`
+	}{Rule: mapper.ToOAPIPairingRule(*rule)})
+}
+
+// PostPairingDelete removes a pairing rule.
+func (h *Handler) PostPairingDelete(c *fiber.Ctx) error {
+	var body api.PostPairingDeleteJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	rule, err := h.uc.DeletePairingRule(c.Context(), body.Id)
+	if err != nil {
+		h.log.Errorw("failed to delete pairing rule", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Rule api.PairingRule `json:"rule"`
+	}{Rule: mapper.ToOAPIPairingRule(*rule)})
+}
+
+// GetPairingRules lists pairing rules, optionally only those mentioning a user.
+func (h *Handler) GetPairingRules(c *fiber.Ctx, params api.GetPairingRulesParams) error {
+	var userID string
+	if params.UserId != nil {
+		userID = *params.UserId
+	}
+	rules, err := h.uc.PairingRules(c.Context(), userID)
+	if err != nil {
+		h.log.Errorw("failed to list pairing rules", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Rules []api.PairingRule `json:"rules"`
+	}{Rules: mapper.ToOAPIPairingRules(rules)})
+}
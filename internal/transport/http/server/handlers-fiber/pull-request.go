@@ -16,19 +16,73 @@ func (h *Handler) PostPullRequestCreate(c *fiber.Ctx) error {
 		h.log.Errorw("failed to parse body", "error", err.Error())
 		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
 	}
-	pr, err := h.uc.CreatePullRequest(c.Context(), entities.PullRequest{
+	pr := entities.PullRequest{
 		ID:       body.PullRequestId,
 		Name:     body.PullRequestName,
 		AuthorID: body.AuthorId,
-	})
+	}
+	if body.Labels != nil {
+		pr.Labels = *body.Labels
+	}
+	if body.ChangedFiles != nil {
+		pr.ChangedFiles = *body.ChangedFiles
+	}
+	if body.Draft != nil && *body.Draft {
+		pr.Status = entities.StatusDraft
+	}
+	created, err := h.uc.CreatePullRequest(c.Context(), pr)
 	if err != nil {
 		return writeError(c, err)
 	}
 	return c.Status(http.StatusCreated).JSON(struct {
 		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*created)})
+}
+
+// GetPullRequestGet returns a PR with its reviewers, reviews and labels.
+func (h *Handler) GetPullRequestGet(c *fiber.Ctx, params api.GetPullRequestGetParams) error {
+	pr, err := h.uc.PullRequest(c.Context(), params.PullRequestId)
+	if err != nil {
+		h.log.Errorw("failed to get pr", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
 	}{PR: mapper.ToOAPIPull(*pr)})
 }
 
+// GetPullRequestList lists PRs matching the filters, newest first, one page at a time.
+func (h *Handler) GetPullRequestList(c *fiber.Ctx, params api.GetPullRequestListParams) error {
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	filter := entities.PullRequestFilter{
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		Limit:       limit,
+	}
+	if params.Status != nil {
+		status := entities.PullRequestStatus(*params.Status)
+		filter.Status = &status
+	}
+	if params.AuthorId != nil {
+		filter.AuthorID = *params.AuthorId
+	}
+	if params.ReviewerId != nil {
+		filter.ReviewerID = *params.ReviewerId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	page, err := h.uc.PullRequests(c.Context(), filter, cursor)
+	if err != nil {
+		h.log.Errorw("failed to list prs", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PullRequests []api.PullRequest `json:"pull_requests"`
+		NextCursor   string            `json:"next_cursor,omitempty"`
+	}{PullRequests: mapper.ToOAPIPullList(page.Items), NextCursor: page.NextCursor})
+}
+
 // PostPullRequestMerge handles idempotent merge of PR.
 func (h *Handler) PostPullRequestMerge(c *fiber.Ctx) error {
 	var body api.PostPullRequestMergeJSONRequestBody
@@ -45,14 +99,44 @@ func (h *Handler) PostPullRequestMerge(c *fiber.Ctx) error {
 	}{PR: mapper.ToOAPIPull(*pr)})
 }
 
-// PostPullRequestReassign swaps a reviewer within team.
+// PostPullRequestPreviewAssignment shows the would-be reviewers of a PR without creating it.
+func (h *Handler) PostPullRequestPreviewAssignment(c *fiber.Ctx) error {
+	var body api.PostPullRequestPreviewAssignmentJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr := entities.PullRequest{AuthorID: body.AuthorId}
+	if body.PullRequestName != nil {
+		pr.Name = *body.PullRequestName
+	}
+	if body.Labels != nil {
+		pr.Labels = *body.Labels
+	}
+	if body.ChangedFiles != nil {
+		pr.ChangedFiles = *body.ChangedFiles
+	}
+	preview, err := h.uc.PreviewAssignment(c.Context(), pr)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Preview api.AssignmentPreview `json:"preview"`
+	}{Preview: mapper.ToOAPIAssignmentPreview(*preview)})
+}
+
+// PostPullRequestReassign swaps a reviewer within team or for the requested user.
 func (h *Handler) PostPullRequestReassign(c *fiber.Ctx) error {
 	var body api.PostPullRequestReassignJSONRequestBody
 	if err := c.BodyParser(&body); err != nil {
 		h.log.Errorw("failed to parse body", "error", err.Error())
 		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
 	}
-	pr, replaced, err := h.uc.ReassignPullRequest(c.Context(), body.PullRequestId, body.OldUserId)
+	var newUserID string
+	if body.NewUserId != nil {
+		newUserID = *body.NewUserId
+	}
+	pr, replaced, err := h.uc.ReassignPullRequest(c.Context(), body.PullRequestId, body.OldUserId, newUserID)
 	if err != nil {
 		return writeError(c, err)
 	}
@@ -61,3 +145,105 @@ func (h *Handler) PostPullRequestReassign(c *fiber.Ctx) error {
 		ReplacedBy string          `json:"replaced_by"`
 	}{PR: mapper.ToOAPIPull(*pr), ReplacedBy: replaced})
 }
+
+// PostPullRequestAddReviewer assigns one more reviewer to an open PR.
+func (h *Handler) PostPullRequestAddReviewer(c *fiber.Ctx) error {
+	var body api.PostPullRequestAddReviewerJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr, err := h.uc.AddReviewer(c.Context(), body.PullRequestId, body.UserId)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*pr)})
+}
+
+// PostPullRequestRemoveReviewer unassigns a reviewer from an open PR.
+func (h *Handler) PostPullRequestRemoveReviewer(c *fiber.Ctx) error {
+	var body api.PostPullRequestRemoveReviewerJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr, err := h.uc.RemoveReviewer(c.Context(), body.PullRequestId, body.UserId)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*pr)})
+}
+
+// PostPullRequestSubmitReview records a reviewer decision; declined reviews are handed over automatically.
+func (h *Handler) PostPullRequestSubmitReview(c *fiber.Ctx) error {
+	var body api.PostPullRequestSubmitReviewJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr, repl, err := h.uc.SubmitReview(c.Context(), body.PullRequestId, body.UserId, entities.ReviewState(body.State))
+	if err != nil {
+		return writeError(c, err)
+	}
+	resp := struct {
+		PR         api.PullRequest `json:"pr"`
+		ReplacedBy string          `json:"replaced_by,omitempty"`
+	}{PR: mapper.ToOAPIPull(*pr), ReplacedBy: repl}
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// PostPullRequestReady moves a draft PR to OPEN and assigns its reviewers.
+func (h *Handler) PostPullRequestReady(c *fiber.Ctx) error {
+	var body api.PostPullRequestReadyJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	var changedFiles []string
+	if body.ChangedFiles != nil {
+		changedFiles = *body.ChangedFiles
+	}
+	pr, err := h.uc.ReadyPullRequest(c.Context(), body.PullRequestId, changedFiles)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*pr)})
+}
+
+// PostPullRequestClose abandons a PR without merging it.
+func (h *Handler) PostPullRequestClose(c *fiber.Ctx) error {
+	var body api.PostPullRequestCloseJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr, err := h.uc.ClosePullRequest(c.Context(), body.PullRequestId)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*pr)})
+}
+
+// PostPullRequestReopen brings a closed PR back to its previous state.
+func (h *Handler) PostPullRequestReopen(c *fiber.Ctx) error {
+	var body api.PostPullRequestReopenJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	pr, err := h.uc.ReopenPullRequest(c.Context(), body.PullRequestId)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		PR api.PullRequest `json:"pr"`
+	}{PR: mapper.ToOAPIPull(*pr)})
+}
@@ -36,6 +36,9 @@ func (h *Handler) GetStatsSummary(c *fiber.Ctx, params api.GetStatsSummaryParams
 	if params.Limit != nil && *params.Limit > 0 {
 		filter.Limit = int(*params.Limit)
 	}
+	if params.Rollup != nil {
+		filter.Rollup = *params.Rollup
+	}
 
 	summary, err := h.uc.SummaryStats(c.Context(), filter)
 	if err != nil {
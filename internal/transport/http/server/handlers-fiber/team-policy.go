@@ -0,0 +1,40 @@
+package handlers_fiber
+
+import (
+	"net/http"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTeamPolicy returns reviewer assignment policy of a team.
+func (h *Handler) GetTeamPolicy(c *fiber.Ctx, params api.GetTeamPolicyParams) error {
+	policy, err := h.uc.TeamPolicy(c.Context(), params.TeamName)
+	if err != nil {
+		h.log.Errorw("failed to get team policy", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(mapper.ToOAPITeamPolicy(*policy))
+}
+
+// PostTeamPolicy stores reviewer assignment policy of a team.
+func (h *Handler) PostTeamPolicy(c *fiber.Ctx) error {
+	var body api.PostTeamPolicyJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	body.TeamName = strings.TrimSpace(body.TeamName)
+
+	policy, err := h.uc.SetTeamPolicy(c.Context(), mapper.FromOAPITeamPolicy(body))
+	if err != nil {
+		h.log.Errorw("failed to set team policy", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(struct {
+		Policy api.TeamPolicy `json:"policy"`
+	}{Policy: mapper.ToOAPITeamPolicy(*policy)})
+}
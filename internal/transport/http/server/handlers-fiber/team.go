@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/mapper"
 	api "assigning-reviewers-for-pr/internal/oapi"
 	"github.com/gofiber/fiber/v2"
@@ -27,9 +28,10 @@ func (h *Handler) PostTeamAdd(c *fiber.Ctx) error {
 	}{Team: mapper.ToOAPITeam(*team)})
 }
 
-// GetTeamGet returns team with members by name.
+// GetTeamGet returns team with members by name, optionally with the teams below it.
 func (h *Handler) GetTeamGet(c *fiber.Ctx, params api.GetTeamGetParams) error {
-	team, err := h.uc.Team(c.Context(), params.TeamName)
+	withDescendants := params.IncludeDescendants != nil && *params.IncludeDescendants
+	team, err := h.uc.Team(c.Context(), params.TeamName, withDescendants)
 	if err != nil {
 		h.log.Errorw("failed to get team", "error", err.Error())
 		return writeError(c, err)
@@ -37,6 +39,98 @@ func (h *Handler) GetTeamGet(c *fiber.Ctx, params api.GetTeamGetParams) error {
 	return c.Status(http.StatusOK).JSON(mapper.ToOAPITeam(*team))
 }
 
+// GetTeamList lists teams by name with their member counts, one page at a time.
+func (h *Handler) GetTeamList(c *fiber.Ctx, params api.GetTeamListParams) error {
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	filter := entities.TeamFilter{Limit: limit}
+	if params.Query != nil {
+		filter.Query = *params.Query
+	}
+	page, err := h.uc.Teams(c.Context(), filter, cursor)
+	if err != nil {
+		h.log.Errorw("failed to list teams", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Teams      []api.TeamSummary `json:"teams"`
+		NextCursor string            `json:"next_cursor,omitempty"`
+	}{Teams: mapper.ToOAPITeamSummaries(page.Items), NextCursor: page.NextCursor})
+}
+
+// PostTeamAddMember adds a user to a team, moving them from another one when forced.
+func (h *Handler) PostTeamAddMember(c *fiber.Ctx) error {
+	var body api.PostTeamAddMemberJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	teamName := strings.TrimSpace(body.TeamName)
+	force := body.Force != nil && *body.Force
+	res, err := h.uc.AddTeamMember(c.Context(), teamName, mapper.FromOAPITeamMember(teamName, body.Member), force)
+	if err != nil {
+		h.log.Errorw("failed to add team member", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(mapper.ToOAPIMembershipResult(res))
+}
+
+// PostTeamRemoveMember takes a user out of a team.
+func (h *Handler) PostTeamRemoveMember(c *fiber.Ctx) error {
+	var body api.PostTeamRemoveMemberJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	res, err := h.uc.RemoveTeamMember(c.Context(), strings.TrimSpace(body.TeamName), body.UserId)
+	if err != nil {
+		h.log.Errorw("failed to remove team member", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(mapper.ToOAPIMembershipResult(res))
+}
+
+// PostTeamRename changes the name of a team.
+func (h *Handler) PostTeamRename(c *fiber.Ctx) error {
+	var body api.PostTeamRenameJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	team, err := h.uc.RenameTeam(c.Context(), strings.TrimSpace(body.TeamName), strings.TrimSpace(body.NewTeamName))
+	if err != nil {
+		h.log.Errorw("failed to rename team", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Team api.Team `json:"team"`
+	}{Team: mapper.ToOAPITeam(*team)})
+}
+
+// PostTeamSetParent moves a team with its descendants under another team or to the top level.
+func (h *Handler) PostTeamSetParent(c *fiber.Ctx) error {
+	var body api.PostTeamSetParentJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	var parent string
+	if body.ParentTeamName != nil {
+		parent = strings.TrimSpace(*body.ParentTeamName)
+	}
+	team, err := h.uc.SetTeamParent(c.Context(), strings.TrimSpace(body.TeamName), parent)
+	if err != nil {
+		h.log.Errorw("failed to set team parent", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Team api.Team `json:"team"`
+	}{Team: mapper.ToOAPITeam(*team)})
+}
+
 // PostTeamDeactivate деактивирует пользователей команды и переназначает ревьюеров.
 func (h *Handler) PostTeamDeactivate(c *fiber.Ctx) error {
 	var body api.PostTeamDeactivateJSONRequestBody
@@ -50,7 +144,8 @@ func (h *Handler) PostTeamDeactivate(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "team_name is required"))
 	}
 
-	res, err := h.uc.DeactivateTeam(c.Context(), teamName)
+	withDescendants := body.IncludeDescendants != nil && *body.IncludeDescendants
+	res, err := h.uc.DeactivateTeam(c.Context(), teamName, withDescendants)
 	if err != nil {
 		h.log.Errorw("failed to deactivate team", "error", err.Error())
 		return writeError(c, err)
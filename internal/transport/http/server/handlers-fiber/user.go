@@ -3,6 +3,7 @@ package handlers_fiber
 import (
 	"net/http"
 
+	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/mapper"
 	api "assigning-reviewers-for-pr/internal/oapi"
 	"github.com/gofiber/fiber/v2"
@@ -10,7 +11,15 @@ import (
 
 // GetUsersGetReview returns PRs where user is reviewer.
 func (h *Handler) GetUsersGetReview(c *fiber.Ctx, params api.GetUsersGetReviewParams) error {
-	prs, err := h.uc.GetReviewList(c.Context(), params.UserId)
+	var label string
+	if params.Label != nil {
+		label = *params.Label
+	}
+	var state entities.ReviewState
+	if params.State != nil {
+		state = entities.ReviewState(*params.State)
+	}
+	prs, err := h.uc.GetReviewList(c.Context(), params.UserId, label, state)
 	if err != nil {
 		h.log.Errorw("failed to get review list", "error", err.Error())
 		return writeError(c, err)
@@ -27,6 +36,27 @@ func (h *Handler) GetUsersGetReview(c *fiber.Ctx, params api.GetUsersGetReviewPa
 	return c.Status(http.StatusOK).JSON(resp)
 }
 
+// GetUsersList lists users matching the filters by ID, one page at a time.
+func (h *Handler) GetUsersList(c *fiber.Ctx, params api.GetUsersListParams) error {
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	filter := entities.UserFilter{IsActive: params.IsActive, Limit: limit}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.Query != nil {
+		filter.Query = *params.Query
+	}
+	page, err := h.uc.Users(c.Context(), filter, cursor)
+	if err != nil {
+		h.log.Errorw("failed to list users", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Users      []api.User `json:"users"`
+		NextCursor string     `json:"next_cursor,omitempty"`
+	}{Users: mapper.ToOAPIUserList(page.Items), NextCursor: page.NextCursor})
+}
+
 // PostUsersSetIsActive toggles user activity flag.
 func (h *Handler) PostUsersSetIsActive(c *fiber.Ctx) error {
 	var body api.PostUsersSetIsActiveJSONRequestBody
@@ -46,3 +76,88 @@ func (h *Handler) PostUsersSetIsActive(c *fiber.Ctx) error {
 	}{User: mapper.ToOAPIUser(*usr)}
 	return c.Status(http.StatusOK).JSON(resp)
 }
+
+// PostUsersSetSkills replaces user skills.
+func (h *Handler) PostUsersSetSkills(c *fiber.Ctx) error {
+	var body api.PostUsersSetSkillsJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	usr, err := h.uc.SetUserSkills(c.Context(), body.UserId, body.Skills)
+	if err != nil {
+		h.log.Errorw("failed to set skills for user", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	resp := struct {
+		User api.User `json:"user"`
+	}{User: mapper.ToOAPIUser(*usr)}
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// PostUsersSetSchedule replaces user time zone and working hours.
+func (h *Handler) PostUsersSetSchedule(c *fiber.Ctx) error {
+	var body api.PostUsersSetScheduleJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+	schedule, err := mapper.FromOAPIWorkSchedule(body.Schedule)
+	if err != nil {
+		h.log.Errorw("failed to parse schedule", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	usr, err := h.uc.SetUserSchedule(c.Context(), body.UserId, schedule)
+	if err != nil {
+		h.log.Errorw("failed to set schedule for user", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	resp := struct {
+		User api.User `json:"user"`
+	}{User: mapper.ToOAPIUser(*usr)}
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// PostUsersSetCapacity replaces the user's review cap and weight.
+func (h *Handler) PostUsersSetCapacity(c *fiber.Ctx) error {
+	var body api.PostUsersSetCapacityJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	usr, err := h.uc.SetUserCapacity(c.Context(), body.UserId, mapper.FromOAPIReviewCapacity(body.MaxOpenReviews, body.ReviewWeight))
+	if err != nil {
+		h.log.Errorw("failed to set capacity for user", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	resp := struct {
+		User api.User `json:"user"`
+	}{User: mapper.ToOAPIUser(*usr)}
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// PostUsersSetRole updates the user's seniority role.
+func (h *Handler) PostUsersSetRole(c *fiber.Ctx) error {
+	var body api.PostUsersSetRoleJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	usr, err := h.uc.SetUserRole(c.Context(), body.UserId, entities.Role(body.Role))
+	if err != nil {
+		h.log.Errorw("failed to set role for user", "error", err.Error())
+		return writeError(c, err)
+	}
+
+	resp := struct {
+		User api.User `json:"user"`
+	}{User: mapper.ToOAPIUser(*usr)}
+	return c.Status(http.StatusOK).JSON(resp)
+}
@@ -0,0 +1,86 @@
+package handlers_fiber
+
+import (
+	"net/http"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/mapper"
+	api "assigning-reviewers-for-pr/internal/oapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PostWebhooksAdd subscribes a URL to domain events.
+func (h *Handler) PostWebhooksAdd(c *fiber.Ctx) error {
+	var body api.PostWebhooksAddJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	events := make([]entities.WebhookEvent, 0, len(body.Events))
+	for _, e := range body.Events {
+		events = append(events, entities.WebhookEvent(e))
+	}
+	sub, err := h.uc.AddWebhook(c.Context(), entities.WebhookSubscription{
+		URL:    body.Url,
+		Secret: body.Secret,
+		Events: events,
+	})
+	if err != nil {
+		h.log.Errorw("failed to add webhook", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(struct {
+		Webhook api.WebhookSubscription `json:"webhook"`
+	}{Webhook: mapper.ToOAPIWebhook(*sub)})
+}
+
+// PostWebhooksDelete removes a webhook subscription with its delivery log.
+func (h *Handler) PostWebhooksDelete(c *fiber.Ctx) error {
+	var body api.PostWebhooksDeleteJSONRequestBody
+	if err := c.BodyParser(&body); err != nil {
+		h.log.Errorw("failed to parse body", "error", err.Error())
+		return c.Status(http.StatusBadRequest).JSON(errorResponse(api.NOTFOUND, "invalid body"))
+	}
+
+	sub, err := h.uc.DeleteWebhook(c.Context(), body.Id)
+	if err != nil {
+		h.log.Errorw("failed to delete webhook", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Webhook api.WebhookSubscription `json:"webhook"`
+	}{Webhook: mapper.ToOAPIWebhook(*sub)})
+}
+
+// GetWebhooksSubscriptions lists webhook subscriptions.
+func (h *Handler) GetWebhooksSubscriptions(c *fiber.Ctx) error {
+	subs, err := h.uc.Webhooks(c.Context())
+	if err != nil {
+		h.log.Errorw("failed to list webhooks", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Webhooks []api.WebhookSubscription `json:"webhooks"`
+	}{Webhooks: mapper.ToOAPIWebhooks(subs)})
+}
+
+// GetWebhooksDeliveries returns the webhook delivery log, newest first.
+func (h *Handler) GetWebhooksDeliveries(c *fiber.Ctx, params api.GetWebhooksDeliveriesParams) error {
+	filter := entities.DeliveryFilter{SubscriptionID: params.SubscriptionId}
+	if params.Status != nil {
+		status := entities.DeliveryStatus(*params.Status)
+		filter.Status = &status
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+	deliveries, err := h.uc.WebhookDeliveries(c.Context(), filter)
+	if err != nil {
+		h.log.Errorw("failed to list webhook deliveries", "error", err.Error())
+		return writeError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(struct {
+		Deliveries []api.WebhookDelivery `json:"deliveries"`
+	}{Deliveries: mapper.ToOAPIWebhookDeliveries(deliveries)})
+}
@@ -0,0 +1,101 @@
+// Package codehost translates pull request webhooks of code hosts into domain events
+// and pushes assigned reviewers back to them.
+package codehost
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// HostGitHub scopes login mappings to GitHub.
+	HostGitHub = "github"
+	// HostGitLab scopes login mappings to GitLab.
+	HostGitLab = "gitlab"
+)
+
+// Logins maps code host logins to user IDs; lookups ignore case like the code hosts do.
+type Logins map[string]string
+
+// UserID returns the user mapped to login, or login itself when it is not mapped.
+func (l Logins) UserID(login string) string {
+	if id, ok := l[strings.ToLower(login)]; ok {
+		return id
+	}
+	return login
+}
+
+// Login returns the login mapped to userID, or userID itself when no login is mapped to it.
+// When several logins map to the same user the alphabetically first one wins.
+func (l Logins) Login(userID string) string {
+	res := ""
+	for login, id := range l {
+		if id == userID && (res == "" || login < res) {
+			res = login
+		}
+	}
+	if res == "" {
+		return userID
+	}
+	return res
+}
+
+// LoginMap holds the logins shared by all code hosts and the ones scoped to a single host.
+type LoginMap struct {
+	shared Logins
+	scoped map[string]Logins
+}
+
+// ParseLogins reads a "login=user_id,host:login=user_id" list, where host is github or gitlab.
+// An empty string gives an empty mapping.
+func ParseLogins(s string) (LoginMap, error) {
+	res := LoginMap{shared: make(Logins), scoped: make(map[string]Logins)}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		login, userID, ok := strings.Cut(pair, "=")
+		login, userID = strings.TrimSpace(login), strings.TrimSpace(userID)
+		target := res.shared
+		if host, scopedLogin, scoped := strings.Cut(login, ":"); scoped {
+			host = strings.ToLower(strings.TrimSpace(host))
+			if host != HostGitHub && host != HostGitLab {
+				return LoginMap{}, fmt.Errorf("invalid login mapping %q: unknown code host %q", pair, host)
+			}
+			if res.scoped[host] == nil {
+				res.scoped[host] = make(Logins)
+			}
+			target, login = res.scoped[host], strings.TrimSpace(scopedLogin)
+		}
+		if !ok || login == "" || userID == "" {
+			return LoginMap{}, fmt.Errorf("invalid login mapping %q: want [host:]login=user_id", pair)
+		}
+		key := strings.ToLower(login)
+		if _, dup := target[key]; dup {
+			return LoginMap{}, fmt.Errorf("login %q is mapped twice", login)
+		}
+		target[key] = userID
+	}
+	return res, nil
+}
+
+// For returns the logins in effect on host: a user with logins scoped to host
+// is known there by those logins only, everyone else by the shared ones.
+func (m LoginMap) For(host string) Logins {
+	scoped := m.scoped[host]
+	users := make(map[string]struct{}, len(scoped))
+	for _, id := range scoped {
+		users[id] = struct{}{}
+	}
+	res := make(Logins, len(m.shared)+len(scoped))
+	for login, id := range m.shared {
+		if _, ok := users[id]; !ok {
+			res[login] = id
+		}
+	}
+	for login, id := range scoped {
+		res[login] = id
+	}
+	return res
+}
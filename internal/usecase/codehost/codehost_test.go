@@ -0,0 +1,42 @@
+package codehost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogins(t *testing.T) {
+	m, err := ParseLogins(" OctoCat = u1, hubot=u2 ,")
+	require.NoError(t, err)
+	logins := m.For(HostGitHub)
+	require.Equal(t, Logins{"octocat": "u1", "hubot": "u2"}, logins)
+	require.Equal(t, "u1", logins.UserID("octocat"))
+	require.Equal(t, "u2", logins.UserID("HUBOT"))
+	require.Equal(t, "monalisa", logins.UserID("monalisa"))
+	require.Equal(t, "octocat", logins.Login("u1"))
+	require.Equal(t, "u3", logins.Login("u3"))
+
+	empty, err := ParseLogins("")
+	require.NoError(t, err)
+	require.Empty(t, empty.For(HostGitLab))
+
+	for _, bad := range []string{"octocat", "octocat=", "=u1", "octocat=u1,OctoCat=u2", "bitbucket:octocat=u1", "github:=u1"} {
+		_, err := ParseLogins(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestParseLoginsScopedToHost(t *testing.T) {
+	m, err := ParseLogins("octo.cat=u1,github:OctoCat=u1,hubot=u2,gitlab:hubot=u3")
+	require.NoError(t, err)
+
+	github := m.For(HostGitHub)
+	require.Equal(t, Logins{"octocat": "u1", "hubot": "u2"}, github)
+	require.Equal(t, "octocat", github.Login("u1"))
+
+	gitlab := m.For(HostGitLab)
+	require.Equal(t, Logins{"octo.cat": "u1", "hubot": "u3"}, gitlab)
+	require.Equal(t, "u3", gitlab.UserID("hubot"))
+	require.Equal(t, "octo.cat", gitlab.Login("u1"))
+}
@@ -0,0 +1,112 @@
+package codehost
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+const (
+	// GitHubSignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body keyed by the webhook secret.
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+	// GitHubEventHeader names the event type of a delivery.
+	GitHubEventHeader = "X-GitHub-Event"
+	// GitHubDeliveryHeader is the unique ID of a delivery, kept for logging.
+	GitHubDeliveryHeader = "X-GitHub-Delivery"
+
+	githubPullRequestEvent = "pull_request"
+	githubSignaturePrefix  = "sha256="
+)
+
+// GitHub verifies and parses GitHub webhook deliveries.
+type GitHub struct {
+	secret []byte
+	logins Logins
+}
+
+// NewGitHub builds a GitHub integration checking deliveries against secret.
+func NewGitHub(secret string, logins Logins) *GitHub {
+	if logins == nil {
+		logins = make(Logins)
+	}
+	return &GitHub{secret: []byte(secret), logins: logins}
+}
+
+// githubPullRequestPayload is the part of a pull_request delivery the service uses.
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// Verify checks signature, the X-Hub-Signature-256 header value, against body in constant time.
+func (g *GitHub) Verify(body []byte, signature string) error {
+	sum, ok := strings.CutPrefix(signature, githubSignaturePrefix)
+	if !ok {
+		return fmt.Errorf("%w: %s must start with %s", entities.ErrInvalidSignature, GitHubSignatureHeader, githubSignaturePrefix)
+	}
+	got, err := hex.DecodeString(sum)
+	if err != nil {
+		return fmt.Errorf("%w: %s is not hex", entities.ErrInvalidSignature, GitHubSignatureHeader)
+	}
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return entities.ErrInvalidSignature
+	}
+	return nil
+}
+
+// Parse translates a verified delivery of the given event type. ok is false for events and
+// actions the service does not track, such as ping or pull_request edits.
+// PR IDs are "owner/repo#number" so PRs of different repositories never collide.
+func (g *GitHub) Parse(event string, body []byte) (res entities.CodeHostEvent, ok bool, err error) {
+	if event != githubPullRequestEvent {
+		return res, false, nil
+	}
+	var p githubPullRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return res, false, fmt.Errorf("%w: malformed pull_request payload: %v", entities.ErrInvalidArgument, err)
+	}
+	if p.Repository.FullName == "" || p.Number <= 0 {
+		return res, false, fmt.Errorf("%w: pull_request payload without repository or number", entities.ErrInvalidArgument)
+	}
+	res.PR.ID = fmt.Sprintf("%s#%d", p.Repository.FullName, p.Number)
+
+	switch p.Action {
+	case "opened":
+		res.Action = entities.CodeHostOpened
+		res.PR.Name = p.PullRequest.Title
+		res.PR.AuthorID = g.logins.UserID(p.PullRequest.User.Login)
+		if p.PullRequest.Draft {
+			res.PR.Status = entities.StatusDraft
+		}
+	case "ready_for_review":
+		res.Action = entities.CodeHostReady
+	case "closed":
+		res.Action = entities.CodeHostClosed
+		if p.PullRequest.Merged {
+			res.Action = entities.CodeHostMerged
+		}
+	case "reopened":
+		res.Action = entities.CodeHostReopened
+	default:
+		return res, false, nil
+	}
+	return res, true, nil
+}
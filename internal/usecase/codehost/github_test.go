@@ -0,0 +1,128 @@
+package codehost
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "It's a Secret to Everybody"
+
+func readFixture(t *testing.T, host, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", host, name))
+	require.NoError(t, err)
+	return body
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGitHubVerify(t *testing.T) {
+	gh := NewGitHub(testSecret, nil)
+
+	// Example from the GitHub webhook documentation.
+	require.NoError(t, gh.Verify([]byte("Hello, World!"), "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"))
+
+	body := readFixture(t, "github", "pull_request_opened.json")
+	require.NoError(t, gh.Verify(body, sign(testSecret, body)))
+
+	tampered := append([]byte{}, body...)
+	tampered[len(tampered)-2] = ' '
+	for name, signature := range map[string]string{
+		"tampered body": sign(testSecret, tampered),
+		"wrong secret":  sign("other", body),
+		"missing":       "",
+		"sha1 prefix":   "sha1=" + sign(testSecret, body)[len("sha256="):],
+		"not hex":       "sha256=zz",
+	} {
+		require.ErrorIs(t, gh.Verify(body, signature), entities.ErrInvalidSignature, name)
+	}
+}
+
+func TestGitHubParseFixtures(t *testing.T) {
+	gh := NewGitHub(testSecret, Logins{"octocat": "u1"})
+	const prID = "octo-org/hello-world#42"
+
+	tests := []struct {
+		fixture string
+		event   string
+		ok      bool
+		want    entities.CodeHostEvent
+	}{
+		{
+			fixture: "pull_request_opened.json",
+			event:   "pull_request",
+			ok:      true,
+			want: entities.CodeHostEvent{Action: entities.CodeHostOpened, PR: entities.PullRequest{
+				ID: prID, Name: "Cache reviewer load per team", AuthorID: "u1",
+			}},
+		},
+		{
+			fixture: "pull_request_opened_draft.json",
+			event:   "pull_request",
+			ok:      true,
+			want: entities.CodeHostEvent{Action: entities.CodeHostOpened, PR: entities.PullRequest{
+				ID: prID, Name: "Cache reviewer load per team", AuthorID: "u1", Status: entities.StatusDraft,
+			}},
+		},
+		{
+			fixture: "pull_request_ready_for_review.json",
+			event:   "pull_request",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostReady, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "pull_request_closed_merged.json",
+			event:   "pull_request",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostMerged, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "pull_request_closed.json",
+			event:   "pull_request",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostClosed, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "pull_request_reopened.json",
+			event:   "pull_request",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostReopened, PR: entities.PullRequest{ID: prID}},
+		},
+		{fixture: "pull_request_labeled.json", event: "pull_request"},
+		{fixture: "ping.json", event: "ping"},
+	}
+
+	for _, tt := range tests {
+		got, ok, err := gh.Parse(tt.event, readFixture(t, "github", tt.fixture))
+		require.NoError(t, err, tt.fixture)
+		require.Equal(t, tt.ok, ok, tt.fixture)
+		if tt.ok {
+			require.Equal(t, tt.want, got, tt.fixture)
+		}
+	}
+}
+
+func TestGitHubParseUnmappedLoginAndMalformed(t *testing.T) {
+	gh := NewGitHub(testSecret, nil)
+
+	got, ok, err := gh.Parse("pull_request", readFixture(t, "github", "pull_request_opened.json"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "OctoCat", got.PR.AuthorID)
+
+	_, _, err = gh.Parse("pull_request", []byte(`{"action":"opened"`))
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, _, err = gh.Parse("pull_request", []byte(`{"action":"opened","number":1}`))
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
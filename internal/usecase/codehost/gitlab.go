@@ -0,0 +1,112 @@
+package codehost
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+const (
+	// GitLabTokenHeader carries the secret token configured for the webhook as is.
+	GitLabTokenHeader = "X-Gitlab-Token"
+	// GitLabEventHeader names the event type of a delivery.
+	GitLabEventHeader = "X-Gitlab-Event"
+	// GitLabDeliveryHeader is the unique ID of a delivery, kept for logging.
+	GitLabDeliveryHeader = "X-Gitlab-Event-UUID"
+
+	gitlabMergeRequestEvent = "Merge Request Hook"
+	gitlabMergeRequestKind  = "merge_request"
+)
+
+// GitLab verifies and parses GitLab webhook deliveries.
+type GitLab struct {
+	token  []byte
+	logins Logins
+}
+
+// NewGitLab builds a GitLab integration accepting deliveries that carry token.
+func NewGitLab(token string, logins Logins) *GitLab {
+	if logins == nil {
+		logins = make(Logins)
+	}
+	return &GitLab{token: []byte(token), logins: logins}
+}
+
+// gitlabMergeRequestPayload is the part of a Merge Request Hook delivery the service uses.
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int    `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Draft  bool   `json:"draft"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+// Verify checks token, the X-Gitlab-Token header value, in constant time.
+func (g *GitLab) Verify(token string) error {
+	if subtle.ConstantTimeCompare([]byte(token), g.token) != 1 {
+		return fmt.Errorf("%w: %s does not match", entities.ErrInvalidSignature, GitLabTokenHeader)
+	}
+	return nil
+}
+
+// Parse translates a verified delivery of the given event type. ok is false for events and
+// actions the service does not track, such as pipelines or merge request approvals.
+// GitLab does not send the author's username, so an opened MR is attributed to the user who opened it.
+// MR IDs are "group/project!iid", the way GitLab references merge requests.
+func (g *GitLab) Parse(event string, body []byte) (res entities.CodeHostEvent, ok bool, err error) {
+	if event != gitlabMergeRequestEvent {
+		return res, false, nil
+	}
+	var p gitlabMergeRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return res, false, fmt.Errorf("%w: malformed merge request payload: %v", entities.ErrInvalidArgument, err)
+	}
+	if p.ObjectKind != gitlabMergeRequestKind {
+		return res, false, nil
+	}
+	if p.Project.PathWithNamespace == "" || p.ObjectAttributes.IID <= 0 {
+		return res, false, fmt.Errorf("%w: merge request payload without project or iid", entities.ErrInvalidArgument)
+	}
+	res.PR.ID = fmt.Sprintf("%s!%d", p.Project.PathWithNamespace, p.ObjectAttributes.IID)
+
+	switch p.ObjectAttributes.Action {
+	case "open":
+		res.Action = entities.CodeHostOpened
+		res.PR.Name = p.ObjectAttributes.Title
+		res.PR.AuthorID = g.logins.UserID(p.User.Username)
+		if p.ObjectAttributes.Draft {
+			res.PR.Status = entities.StatusDraft
+		}
+	case "update":
+		// Only leaving draft matters; other edits are ignored.
+		if d := p.Changes.Draft; d == nil || !d.Previous || d.Current {
+			return res, false, nil
+		}
+		res.Action = entities.CodeHostReady
+	case "close":
+		res.Action = entities.CodeHostClosed
+	case "merge":
+		res.Action = entities.CodeHostMerged
+	case "reopen":
+		res.Action = entities.CodeHostReopened
+	default:
+		return res, false, nil
+	}
+	return res, true, nil
+}
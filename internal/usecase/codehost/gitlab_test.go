@@ -0,0 +1,91 @@
+package codehost
+
+import (
+	"testing"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabVerify(t *testing.T) {
+	gl := NewGitLab("glsecret", nil)
+
+	require.NoError(t, gl.Verify("glsecret"))
+	for _, token := range []string{"", "glsecre", "glsecret ", "GLSECRET"} {
+		require.ErrorIs(t, gl.Verify(token), entities.ErrInvalidSignature, token)
+	}
+}
+
+func TestGitLabParseFixtures(t *testing.T) {
+	gl := NewGitLab("glsecret", Logins{"root.admin": "u1"})
+	const prID = "platform/gitlab-test!1"
+
+	tests := []struct {
+		fixture string
+		event   string
+		ok      bool
+		want    entities.CodeHostEvent
+	}{
+		{
+			fixture: "merge_request_open.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want: entities.CodeHostEvent{Action: entities.CodeHostOpened, PR: entities.PullRequest{
+				ID: prID, Name: "MS-Viewport", AuthorID: "u1",
+			}},
+		},
+		{
+			fixture: "merge_request_open_draft.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want: entities.CodeHostEvent{Action: entities.CodeHostOpened, PR: entities.PullRequest{
+				ID: prID, Name: "MS-Viewport", AuthorID: "u1", Status: entities.StatusDraft,
+			}},
+		},
+		{
+			fixture: "merge_request_update_ready.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostReady, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "merge_request_merge.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostMerged, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "merge_request_close.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostClosed, PR: entities.PullRequest{ID: prID}},
+		},
+		{
+			fixture: "merge_request_reopen.json",
+			event:   "Merge Request Hook",
+			ok:      true,
+			want:    entities.CodeHostEvent{Action: entities.CodeHostReopened, PR: entities.PullRequest{ID: prID}},
+		},
+		{fixture: "merge_request_update_title.json", event: "Merge Request Hook"},
+		{fixture: "merge_request_approved.json", event: "Merge Request Hook"},
+		{fixture: "push.json", event: "Push Hook"},
+	}
+
+	for _, tt := range tests {
+		got, ok, err := gl.Parse(tt.event, readFixture(t, "gitlab", tt.fixture))
+		require.NoError(t, err, tt.fixture)
+		require.Equal(t, tt.ok, ok, tt.fixture)
+		if tt.ok {
+			require.Equal(t, tt.want, got, tt.fixture)
+		}
+	}
+}
+
+func TestGitLabParseMalformed(t *testing.T) {
+	gl := NewGitLab("glsecret", nil)
+
+	_, _, err := gl.Parse("Merge Request Hook", []byte(`{"object_kind":`))
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, _, err = gl.Parse("Merge Request Hook", []byte(`{"object_kind":"merge_request","object_attributes":{"action":"open"}}`))
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
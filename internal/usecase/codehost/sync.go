@@ -0,0 +1,258 @@
+package codehost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/usecase/outbox"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultGitHubAPIURL is the REST API root of github.com.
+	DefaultGitHubAPIURL = "https://api.github.com"
+
+	githubAPIVersion = "2022-11-28"
+	// maxErrorBody bounds how much of a failed response is kept in the sync status.
+	maxErrorBody = 512
+)
+
+// SyncStore is the outbox the syncer reads pending reviewer pushes from.
+type SyncStore interface {
+	ClaimCodeHostSyncs(ctx context.Context, limit int, lease time.Duration) ([]entities.CodeHostSync, error)
+	RecordCodeHostSync(ctx context.Context, attempt entities.CodeHostSyncAttempt) error
+}
+
+// Repositories maps lower-cased "owner/repo" names to the API token used for them.
+type Repositories map[string]string
+
+// ParseRepositories reads an "owner/repo,owner/repo=token" list; repositories without
+// their own token use defaultToken.
+func ParseRepositories(s, defaultToken string) (Repositories, error) {
+	res := make(Repositories)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, token, _ := strings.Cut(entry, "=")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if owner, repo, ok := strings.Cut(name, "/"); !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+			return nil, fmt.Errorf("invalid repository %q: want owner/repo", name)
+		}
+		if token == "" {
+			token = defaultToken
+		}
+		if token == "" {
+			return nil, fmt.Errorf("repository %q has no API token", name)
+		}
+		res[strings.ToLower(name)] = token
+	}
+	return res, nil
+}
+
+// ParseGitHubPRID splits an "owner/repo#number" PR ID as built from GitHub webhooks.
+func ParseGitHubPRID(id string) (repo string, number int, ok bool) {
+	i := strings.LastIndex(id, "#")
+	if i <= 0 {
+		return "", 0, false
+	}
+	number, err := strconv.Atoi(id[i+1:])
+	if err != nil || number <= 0 {
+		return "", 0, false
+	}
+	return id[:i], number, true
+}
+
+// SyncConfig tunes reviewer push batching and retries.
+type SyncConfig struct {
+	APIURL      string
+	BatchSize   int
+	MaxAttempts int
+	// BackoffBase is the delay after the first failed attempt; it doubles with every further one up to BackoffMax.
+	BackoffBase    time.Duration
+	BackoffMax     time.Duration
+	RequestTimeout time.Duration
+}
+
+// GitHubSyncer requests the assigned reviewers of PRs on GitHub and withdraws the replaced ones.
+type GitHubSyncer struct {
+	log    *zap.SugaredLogger
+	store  SyncStore
+	client *http.Client
+	repos  Repositories
+	logins Logins
+	cfg    SyncConfig
+}
+
+// NewGitHubSyncer constructs a GitHubSyncer for repos, filling unset config values with defaults.
+func NewGitHubSyncer(log *zap.SugaredLogger, store SyncStore, client *http.Client, repos Repositories, logins Logins, cfg SyncConfig) *GitHubSyncer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if logins == nil {
+		logins = make(Logins)
+	}
+	if cfg.APIURL == "" {
+		cfg.APIURL = DefaultGitHubAPIURL
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = 10 * time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = time.Hour
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = 5 * time.Second
+	}
+	return &GitHubSyncer{log: log, store: store, client: client, repos: repos, logins: logins, cfg: cfg}
+}
+
+// SyncDue pushes one batch of pending syncs and returns how many were synced.
+func (s *GitHubSyncer) SyncDue(ctx context.Context) (int, error) {
+	// A sync takes up to two requests: withdrawing the replaced reviewers and requesting the current ones.
+	lease := outbox.Lease(s.cfg.BatchSize, 2, s.cfg.RequestTimeout)
+	syncs, err := s.store.ClaimCodeHostSyncs(ctx, s.cfg.BatchSize, lease)
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for _, sync := range syncs {
+		attempt := s.sync(ctx, sync)
+		if ctx.Err() != nil {
+			// Shutting down: the lease expires and the sync is retried without counting this attempt.
+			return synced, ctx.Err()
+		}
+		if err := s.store.RecordCodeHostSync(ctx, attempt); err != nil {
+			return synced, err
+		}
+		switch attempt.Status {
+		case entities.SyncSynced:
+			synced++
+		case entities.SyncSkipped:
+			s.log.Debugw("code host sync skipped", "pr_id", sync.PRID, "reason", attempt.Error)
+		case entities.SyncFailed:
+			s.log.Warnw("code host sync gave up", "pr_id", sync.PRID, "attempts", sync.Attempts+1, "error", attempt.Error)
+		default:
+			s.log.Infow("code host sync will be retried", "pr_id", sync.PRID, "retry_in", attempt.RetryIn, "error", attempt.Error)
+		}
+	}
+	return synced, nil
+}
+
+// Run pushes pending syncs every interval until ctx is done.
+func (s *GitHubSyncer) Run(ctx context.Context, interval time.Duration) {
+	outbox.Run(ctx, s.log, "github reviewer syncs", interval, s.SyncDue)
+}
+
+// sync withdraws review requests of reviewers no longer assigned, requests the current ones
+// and turns the responses into an attempt outcome.
+func (s *GitHubSyncer) sync(ctx context.Context, sync entities.CodeHostSync) entities.CodeHostSyncAttempt {
+	attempt := entities.CodeHostSyncAttempt{PRID: sync.PRID, Version: sync.Version, Status: entities.SyncSynced}
+
+	repo, number, ok := ParseGitHubPRID(sync.PRID)
+	if !ok {
+		return skipped(attempt, "not a GitHub pull request")
+	}
+	token, ok := s.repos[strings.ToLower(repo)]
+	if !ok {
+		return skipped(attempt, "repository "+repo+" is not configured")
+	}
+	if sync.PRStatus != entities.StatusOpen {
+		return skipped(attempt, "pull request is "+string(sync.PRStatus))
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers", s.cfg.APIURL, repo, number)
+	removed := make([]string, 0)
+	for _, r := range sync.SyncedReviewers {
+		if !slices.Contains(sync.Reviewers, r) {
+			removed = append(removed, s.logins.Login(r))
+		}
+	}
+	var (
+		code int
+		err  error
+	)
+	if len(removed) > 0 {
+		code, err = s.call(ctx, http.MethodDelete, url, token, removed)
+	}
+	if err == nil && len(sync.Reviewers) > 0 {
+		requested := make([]string, 0, len(sync.Reviewers))
+		for _, r := range sync.Reviewers {
+			requested = append(requested, s.logins.Login(r))
+		}
+		code, err = s.call(ctx, http.MethodPost, url, token, requested)
+	}
+	if code != 0 {
+		attempt.ResponseCode = &code
+	}
+	if err == nil {
+		attempt.SyncedReviewers = append(make([]string, 0, len(sync.Reviewers)), sync.Reviewers...)
+		return attempt
+	}
+
+	attempt.Error = err.Error()
+	if sync.Attempts+1 >= s.cfg.MaxAttempts {
+		attempt.Status = entities.SyncFailed
+		return attempt
+	}
+	attempt.Status = entities.SyncPending
+	attempt.RetryIn = outbox.Backoff(sync.Attempts+1, s.cfg.BackoffBase, s.cfg.BackoffMax)
+	return attempt
+}
+
+// call sends the reviewer logins to the requested_reviewers endpoint; non-2xx responses are errors.
+func (s *GitHubSyncer) call(ctx context.Context, method, url, token string, logins []string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.RequestTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(struct {
+		Reviewers []string `json:"reviewers"`
+	}{Reviewers: logins})
+	if err != nil {
+		return 0, fmt.Errorf("encode reviewers: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", githubAPIVersion)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, fmt.Errorf("%s requested_reviewers: unexpected status %d: %s", method, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return resp.StatusCode, nil
+}
+
+func skipped(attempt entities.CodeHostSyncAttempt, reason string) entities.CodeHostSyncAttempt {
+	attempt.Status = entities.SyncSkipped
+	attempt.Error = reason
+	return attempt
+}
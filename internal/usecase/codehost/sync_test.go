@@ -0,0 +1,169 @@
+package codehost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeSyncStore struct {
+	due      []entities.CodeHostSync
+	attempts []entities.CodeHostSyncAttempt
+}
+
+func (s *fakeSyncStore) ClaimCodeHostSyncs(_ context.Context, limit int, _ time.Duration) ([]entities.CodeHostSync, error) {
+	n := min(limit, len(s.due))
+	res := s.due[:n]
+	s.due = s.due[n:]
+	return res, nil
+}
+
+func (s *fakeSyncStore) RecordCodeHostSync(_ context.Context, attempt entities.CodeHostSyncAttempt) error {
+	s.attempts = append(s.attempts, attempt)
+	return nil
+}
+
+// githubCall is one request received by the GitHub stand-in.
+type githubCall struct {
+	method    string
+	path      string
+	auth      string
+	reviewers []string
+}
+
+func newGitHubStandIn(t *testing.T, status int) (*httptest.Server, *[]githubCall) {
+	t.Helper()
+	calls := make([]githubCall, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reviewers []string `json:"reviewers"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+		calls = append(calls, githubCall{method: r.Method, path: r.URL.Path, auth: r.Header.Get("Authorization"), reviewers: body.Reviewers})
+		w.WriteHeader(status)
+		if status >= 300 {
+			_, _ = w.Write([]byte(`{"message":"Reviews may only be requested from collaborators."}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newTestSyncer(store SyncStore, apiURL string) *GitHubSyncer {
+	repos := Repositories{"octo-org/hello-world": "repo-token"}
+	logins := Logins{"octocat": "u3"}
+	return NewGitHubSyncer(zap.NewNop().Sugar(), store, nil, repos, logins, SyncConfig{
+		APIURL:         apiURL,
+		BatchSize:      10,
+		MaxAttempts:    3,
+		BackoffBase:    time.Second,
+		BackoffMax:     3 * time.Second,
+		RequestTimeout: time.Second,
+	})
+}
+
+func TestGitHubSyncerRequestsAndWithdrawsReviewers(t *testing.T) {
+	srv, calls := newGitHubStandIn(t, http.StatusCreated)
+	store := &fakeSyncStore{due: []entities.CodeHostSync{{
+		PRID:            "Octo-Org/hello-world#42",
+		Version:         4,
+		PRStatus:        entities.StatusOpen,
+		SyncedReviewers: []string{"u1", "u2"},
+		Reviewers:       []string{"u2", "u3"},
+	}}}
+
+	n, err := newTestSyncer(store, srv.URL+"/").SyncDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	path := "/repos/Octo-Org/hello-world/pulls/42/requested_reviewers"
+	require.Equal(t, []githubCall{
+		{method: http.MethodDelete, path: path, auth: "Bearer repo-token", reviewers: []string{"u1"}},
+		{method: http.MethodPost, path: path, auth: "Bearer repo-token", reviewers: []string{"u2", "octocat"}},
+	}, *calls)
+	require.Len(t, store.attempts, 1)
+	attempt := store.attempts[0]
+	require.Equal(t, entities.SyncSynced, attempt.Status)
+	require.Equal(t, int64(4), attempt.Version)
+	require.Equal(t, []string{"u2", "u3"}, attempt.SyncedReviewers)
+	require.Equal(t, http.StatusCreated, *attempt.ResponseCode)
+}
+
+func TestGitHubSyncerRetriesThenFails(t *testing.T) {
+	srv, calls := newGitHubStandIn(t, http.StatusUnprocessableEntity)
+	store := &fakeSyncStore{}
+	syncer := newTestSyncer(store, srv.URL)
+
+	for attempts := 0; attempts < 3; attempts++ {
+		store.due = []entities.CodeHostSync{{
+			PRID:      "octo-org/hello-world#42",
+			PRStatus:  entities.StatusOpen,
+			Attempts:  attempts,
+			Reviewers: []string{"u2"},
+		}}
+		n, err := syncer.SyncDue(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, n)
+	}
+
+	require.Len(t, *calls, 3)
+	require.Len(t, store.attempts, 3)
+	for i, want := range []time.Duration{time.Second, 2 * time.Second} {
+		require.Equal(t, entities.SyncPending, store.attempts[i].Status)
+		require.Equal(t, want, store.attempts[i].RetryIn)
+		require.Nil(t, store.attempts[i].SyncedReviewers)
+	}
+	last := store.attempts[2]
+	require.Equal(t, entities.SyncFailed, last.Status)
+	require.Equal(t, http.StatusUnprocessableEntity, *last.ResponseCode)
+	require.Contains(t, last.Error, "Reviews may only be requested from collaborators.")
+}
+
+func TestGitHubSyncerSkips(t *testing.T) {
+	srv, calls := newGitHubStandIn(t, http.StatusCreated)
+	store := &fakeSyncStore{due: []entities.CodeHostSync{
+		{PRID: "pr-1001", PRStatus: entities.StatusOpen, Reviewers: []string{"u2"}},
+		{PRID: "octo-org/other#1", PRStatus: entities.StatusOpen, Reviewers: []string{"u2"}},
+		{PRID: "octo-org/hello-world#42", PRStatus: entities.StatusMerged, Reviewers: []string{"u2"}},
+	}}
+
+	n, err := newTestSyncer(store, srv.URL).SyncDue(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Empty(t, *calls)
+	require.Len(t, store.attempts, 3)
+	for _, a := range store.attempts {
+		require.Equal(t, entities.SyncSkipped, a.Status, a.PRID)
+		require.NotEmpty(t, a.Error, a.PRID)
+	}
+}
+
+func TestParseRepositoriesAndPRID(t *testing.T) {
+	repos, err := ParseRepositories("Octo-Org/Hello-World, octo-org/lib=lib-token", "default-token")
+	require.NoError(t, err)
+	require.Equal(t, Repositories{"octo-org/hello-world": "default-token", "octo-org/lib": "lib-token"}, repos)
+
+	for _, bad := range []string{"octo-org", "octo-org/a/b", "/repo"} {
+		_, err := ParseRepositories(bad, "t")
+		require.Error(t, err, bad)
+	}
+	_, err = ParseRepositories("octo-org/lib", "")
+	require.Error(t, err)
+
+	repo, number, ok := ParseGitHubPRID("octo-org/hello-world#42")
+	require.True(t, ok)
+	require.Equal(t, "octo-org/hello-world", repo)
+	require.Equal(t, 42, number)
+	for _, id := range []string{"pr-1001", "#42", "octo-org/hello-world#", "group/project!1"} {
+		_, _, ok := ParseGitHubPRID(id)
+		require.False(t, ok, id)
+	}
+}
@@ -0,0 +1,136 @@
+// Package domain contains application Usecases orchestrating domain logic by user availability.
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// AddUnavailability validates and stores an out-of-office period.
+func (u *Usecase) AddUnavailability(ctx context.Context, period entities.Unavailability) (*entities.Unavailability, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if period.UserID == "" {
+		u.log.Errorw("failed to add unavailability: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	if period.StartsAt.IsZero() || !period.EndsAt.After(period.StartsAt) {
+		u.log.Errorw("failed to add unavailability: invalid period", "starts_at", period.StartsAt, "ends_at", period.EndsAt)
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", entities.ErrInvalidArgument)
+	}
+	period.Reason = strings.TrimSpace(period.Reason)
+
+	return u.repo.AddUnavailability(ctx, period)
+}
+
+// Unavailability returns current and upcoming out-of-office periods of a user.
+func (u *Usecase) Unavailability(ctx context.Context, userID string) ([]entities.Unavailability, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if userID == "" {
+		u.log.Errorw("failed to list unavailability: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.ListUnavailability(ctx, userID)
+}
+
+// DeleteUnavailability removes an out-of-office period.
+func (u *Usecase) DeleteUnavailability(ctx context.Context, id int64) (*entities.Unavailability, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if id <= 0 {
+		u.log.Errorw("failed to delete unavailability: invalid id", "id", id)
+		return nil, fmt.Errorf("%w: id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.DeleteUnavailability(ctx, id)
+}
+
+// ReassignUnavailableReviews moves open reviews of users whose out-of-office period has just started
+// and requested reassignment. Reviews without an available replacement stay assigned.
+// A period whose reassignment fails is released for the next run and the rest of the batch goes on.
+// It returns the number of reassigned reviews.
+func (u *Usecase) ReassignUnavailableReviews(ctx context.Context) (int, error) {
+	claimCtx, cancel := withTimeout(ctx, u.timeout)
+	periods, err := u.repo.ClaimStartedUnavailability(claimCtx)
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	var errs []error
+	for _, period := range periods {
+		n, err := u.reassignOpenReviews(ctx, period.UserID)
+		reassigned += n
+		if err == nil {
+			continue
+		}
+		u.log.Errorw("failed to reassign reviews of unavailable user", "user_id", period.UserID, "period_id", period.ID, "error", err)
+		errs = append(errs, err)
+		releaseCtx, cancel := withTimeout(ctx, u.timeout)
+		if err := u.repo.ReleaseUnavailabilityClaim(releaseCtx, period.ID); err != nil {
+			u.log.Errorw("failed to release unavailability claim", "period_id", period.ID, "error", err)
+			errs = append(errs, err)
+		}
+		cancel()
+	}
+	return reassigned, errors.Join(errs...)
+}
+
+// RunUnavailabilityWatcher periodically reassigns reviews of users going out of office until ctx is done.
+func (u *Usecase) RunUnavailabilityWatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := u.ReassignUnavailableReviews(ctx)
+			if err != nil {
+				u.log.Errorw("unavailability watcher run failed", "error", err)
+				continue
+			}
+			if n > 0 {
+				u.log.Infow("reviews of unavailable users reassigned", "reassigned", n)
+			}
+		}
+	}
+}
+
+func (u *Usecase) reassignOpenReviews(ctx context.Context, userID string) (int, error) {
+	listCtx, cancel := withTimeout(ctx, u.timeout)
+	prs, err := u.repo.GetUserReviews(listCtx, userID, "", "")
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	for _, pr := range prs {
+		if pr.Status != entities.StatusOpen {
+			continue
+		}
+		reassignCtx, cancel := withTimeout(ctx, u.timeout)
+		_, _, err := u.repo.ReassignReviewer(reassignCtx, pr.ID, userID, u.selector)
+		cancel()
+		switch {
+		case err == nil:
+			reassigned++
+		case keepsReviewer(err):
+			u.log.Warnw("review of unavailable user left as is", "user_id", userID, "pr_id", pr.ID, "reason", err)
+		default:
+			return reassigned, err
+		}
+	}
+	return reassigned, nil
+}
@@ -0,0 +1,109 @@
+// Package domain contains application Usecases orchestrating domain logic by code host events.
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+const (
+	defaultSyncLimit = 50
+	maxSyncLimit     = 500
+)
+
+// ApplyCodeHostEvent replays a code host PR change through the regular PR operations.
+// Redelivered events are no-ops: an opened event for a known PR returns nil without an error,
+// and the other actions are idempotent already.
+func (u *Usecase) ApplyCodeHostEvent(ctx context.Context, event entities.CodeHostEvent) (*entities.PullRequest, error) {
+	switch event.Action {
+	case entities.CodeHostOpened:
+		pr, err := u.CreatePullRequest(ctx, event.PR)
+		if errors.Is(err, entities.ErrPRExists) {
+			u.log.Infow("code host PR already exists", "pr_id", event.PR.ID)
+			return nil, nil
+		}
+		return pr, err
+	case entities.CodeHostReady:
+		return u.ReadyPullRequest(ctx, event.PR.ID, nil)
+	case entities.CodeHostClosed:
+		return u.ClosePullRequest(ctx, event.PR.ID)
+	case entities.CodeHostMerged:
+		return u.recordCodeHostMerge(ctx, event.PR.ID)
+	case entities.CodeHostReopened:
+		return u.ReopenPullRequest(ctx, event.PR.ID)
+	default:
+		u.log.Errorw("failed to apply code host event: unknown action", "action", event.Action, "pr_id", event.PR.ID)
+		return nil, fmt.Errorf("%w: unknown code host action %q", entities.ErrInvalidArgument, event.Action)
+	}
+}
+
+// recordCodeHostMerge marks a PR merged on the code host. The merge has already happened there,
+// so the merge policy of the author team is not applied.
+func (u *Usecase) recordCodeHostMerge(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" {
+		u.log.Errorw("failed to record the code host merge: missing prID")
+		return nil, fmt.Errorf("%w: pull_request_id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.RecordPRMerge(ctx, prID)
+}
+
+// CodeHostSyncs returns the reviewer push state of PRs, most recently changed first;
+// the limit defaults to 50 and is capped at 500.
+func (u *Usecase) CodeHostSyncs(ctx context.Context, filter entities.CodeHostSyncFilter) ([]entities.CodeHostSync, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		u.log.Errorw("failed to list code host syncs: unknown status", "status", *filter.Status)
+		return nil, fmt.Errorf("%w: unknown status %q", entities.ErrInvalidArgument, *filter.Status)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSyncLimit
+	}
+	if filter.Limit > maxSyncLimit {
+		filter.Limit = maxSyncLimit
+	}
+	return u.repo.ListCodeHostSyncs(ctx, filter)
+}
+
+// PruneCodeHostSyncs deletes finished reviewer pushes unchanged for longer than retention.
+// Pending pushes are kept. It returns the number of deleted syncs.
+func (u *Usecase) PruneCodeHostSyncs(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if retention <= 0 {
+		u.log.Errorw("failed to prune code host syncs: invalid retention", "retention", retention)
+		return 0, fmt.Errorf("%w: retention must be positive", entities.ErrInvalidArgument)
+	}
+	return u.repo.PruneCodeHostSyncs(ctx, time.Now().Add(-retention))
+}
+
+// RunCodeHostSyncPruner periodically deletes finished syncs older than retention until ctx is done.
+func (u *Usecase) RunCodeHostSyncPruner(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := u.PruneCodeHostSyncs(ctx, retention)
+			if err != nil {
+				u.log.Errorw("code host sync pruner run failed", "error", err)
+				continue
+			}
+			if n > 0 {
+				u.log.Infow("old code host syncs pruned", "deleted", n)
+			}
+		}
+	}
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	"time"
 
+	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/repository"
+	"assigning-reviewers-for-pr/internal/usecase/stream"
 
 	"go.uber.org/zap"
 )
 
 // Usecase struct implements all usecase interfaces.
 type Usecase struct {
-	ctx     context.Context
-	log     *zap.SugaredLogger
-	repo    repository.Repository
-	timeout time.Duration
+	ctx      context.Context
+	log      *zap.SugaredLogger
+	repo     repository.Repository
+	selector entities.ReviewerSelector
+	events   *stream.Broker
+	timeout  time.Duration
 }
 
 // New constructs a new usecase layer with its dependencies.
@@ -22,12 +26,15 @@ func New(
 	log *zap.SugaredLogger,
 	ctx context.Context,
 	repo repository.Repository,
+	selector entities.ReviewerSelector,
 	timeout time.Duration,
 ) *Usecase {
 	return &Usecase{
-		ctx:     ctx,
-		log:     log,
-		repo:    repo,
-		timeout: timeout,
+		ctx:      ctx,
+		log:      log,
+		repo:     repo,
+		selector: selector,
+		events:   stream.NewBroker(log, repo),
+		timeout:  timeout,
 	}
 }
@@ -2,11 +2,13 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/repository"
+	"assigning-reviewers-for-pr/internal/usecase/selector"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,8 +22,8 @@ var _ repository.Repository = (*repoMock)(nil)
 func (m *repoMock) OnStart(_ context.Context) error { return nil }
 func (m *repoMock) OnStop(_ context.Context) error  { return nil }
 
-func (m *repoMock) CreatePR(ctx context.Context, pr entities.PullRequest) (*entities.PullRequest, error) {
-	args := m.Called(ctx, pr)
+func (m *repoMock) CreatePR(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.PullRequest, error) {
+	args := m.Called(ctx, pr, sel)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -36,8 +38,72 @@ func (m *repoMock) MergePR(ctx context.Context, prID string) (*entities.PullRequ
 	return args.Get(0).(*entities.PullRequest), args.Error(1)
 }
 
-func (m *repoMock) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*entities.PullRequest, string, error) {
-	args := m.Called(ctx, prID, oldUserID)
+func (m *repoMock) RecordPRMerge(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) MarkPRReady(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.PullRequest, error) {
+	args := m.Called(ctx, pr, sel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) ClosePR(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) ReopenPR(ctx context.Context, prID string, sel entities.ReviewerSelector) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID, sel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) AddPairingRule(ctx context.Context, rule entities.PairingRule) (*entities.PairingRule, error) {
+	args := m.Called(ctx, rule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PairingRule), args.Error(1)
+}
+
+func (m *repoMock) ListPairingRules(ctx context.Context, userID string) ([]entities.PairingRule, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PairingRule), args.Error(1)
+}
+
+func (m *repoMock) DeletePairingRule(ctx context.Context, id int64) (*entities.PairingRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PairingRule), args.Error(1)
+}
+
+func (m *repoMock) PreviewAssignment(ctx context.Context, pr entities.PullRequest, sel entities.ReviewerSelector) (*entities.AssignmentPreview, error) {
+	args := m.Called(ctx, pr, sel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AssignmentPreview), args.Error(1)
+}
+
+func (m *repoMock) ReassignReviewer(ctx context.Context, prID, oldUserID string, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldUserID, sel)
 	var pr *entities.PullRequest
 	if args.Get(0) != nil {
 		pr = args.Get(0).(*entities.PullRequest)
@@ -46,6 +112,55 @@ func (m *repoMock) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 	return pr, repl, args.Error(2)
 }
 
+func (m *repoMock) ListStaleReviews(ctx context.Context, sla time.Duration) ([]entities.StaleReview, error) {
+	args := m.Called(ctx, sla)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.StaleReview), args.Error(1)
+}
+
+func (m *repoMock) ReassignStaleReview(ctx context.Context, prID, userID string, sla time.Duration, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	args := m.Called(ctx, prID, userID, sla, sel)
+	var pr *entities.PullRequest
+	if args.Get(0) != nil {
+		pr = args.Get(0).(*entities.PullRequest)
+	}
+	return pr, args.String(1), args.Error(2)
+}
+
+func (m *repoMock) ReplaceReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID, oldUserID, newUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) AddReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) RemoveReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) SubmitReview(ctx context.Context, prID, userID string, state entities.ReviewState, sel entities.ReviewerSelector) (*entities.PullRequest, string, error) {
+	args := m.Called(ctx, prID, userID, state, sel)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).(*entities.PullRequest), args.String(1), args.Error(2)
+}
+
 func (m *repoMock) SetUserActive(ctx context.Context, userID string, isActive bool) (*entities.User, error) {
 	args := m.Called(ctx, userID, isActive)
 	if args.Get(0) == nil {
@@ -54,14 +169,82 @@ func (m *repoMock) SetUserActive(ctx context.Context, userID string, isActive bo
 	return args.Get(0).(*entities.User), args.Error(1)
 }
 
-func (m *repoMock) GetUserReviews(ctx context.Context, userID string) ([]entities.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *repoMock) SetUserSkills(ctx context.Context, userID string, skills []string) (*entities.User, error) {
+	args := m.Called(ctx, userID, skills)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+func (m *repoMock) SetUserSchedule(ctx context.Context, userID string, schedule entities.WorkSchedule) (*entities.User, error) {
+	args := m.Called(ctx, userID, schedule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+func (m *repoMock) SetUserCapacity(ctx context.Context, userID string, capacity entities.ReviewCapacity) (*entities.User, error) {
+	args := m.Called(ctx, userID, capacity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+func (m *repoMock) SetUserRole(ctx context.Context, userID string, role entities.Role) (*entities.User, error) {
+	args := m.Called(ctx, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
+func (m *repoMock) GetUserReviews(ctx context.Context, userID, label string, state entities.ReviewState) ([]entities.PullRequestShort, error) {
+	args := m.Called(ctx, userID, label, state)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]entities.PullRequestShort), args.Error(1)
 }
 
+func (m *repoMock) AddUnavailability(ctx context.Context, period entities.Unavailability) (*entities.Unavailability, error) {
+	args := m.Called(ctx, period)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Unavailability), args.Error(1)
+}
+
+func (m *repoMock) ListUnavailability(ctx context.Context, userID string) ([]entities.Unavailability, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.Unavailability), args.Error(1)
+}
+
+func (m *repoMock) DeleteUnavailability(ctx context.Context, id int64) (*entities.Unavailability, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Unavailability), args.Error(1)
+}
+
+func (m *repoMock) ClaimStartedUnavailability(ctx context.Context) ([]entities.Unavailability, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.Unavailability), args.Error(1)
+}
+
+func (m *repoMock) ReleaseUnavailabilityClaim(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
 func (m *repoMock) CreateTeam(ctx context.Context, team entities.Team) (*entities.Team, error) {
 	args := m.Called(ctx, team)
 	if args.Get(0) == nil {
@@ -78,6 +261,110 @@ func (m *repoMock) GetTeam(ctx context.Context, name string) (*entities.Team, er
 	return args.Get(0).(*entities.Team), args.Error(1)
 }
 
+func (m *repoMock) SetTeamParent(ctx context.Context, name, parent string) (*entities.Team, error) {
+	args := m.Called(ctx, name, parent)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Team), args.Error(1)
+}
+
+func (m *repoMock) ListTeamDescendants(ctx context.Context, name string) ([]entities.Team, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.Team), args.Error(1)
+}
+
+func (m *repoMock) ListTeams(ctx context.Context, filter entities.TeamFilter) ([]entities.TeamSummary, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.TeamSummary), args.Error(1)
+}
+
+func (m *repoMock) ListUsers(ctx context.Context, filter entities.UserFilter) ([]entities.User, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.User), args.Error(1)
+}
+
+func (m *repoMock) GetPR(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) ListPRs(ctx context.Context, filter entities.PullRequestFilter) ([]entities.PullRequest, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PullRequest), args.Error(1)
+}
+
+func (m *repoMock) AddTeamMember(ctx context.Context, teamName string, member entities.User, force bool, sel entities.ReviewerSelector) (entities.MembershipResult, error) {
+	args := m.Called(ctx, teamName, member, force, sel)
+	if args.Get(0) == nil {
+		return entities.MembershipResult{}, args.Error(1)
+	}
+	return args.Get(0).(entities.MembershipResult), args.Error(1)
+}
+
+func (m *repoMock) RemoveTeamMember(ctx context.Context, teamName, userID string, sel entities.ReviewerSelector) (entities.MembershipResult, error) {
+	args := m.Called(ctx, teamName, userID, sel)
+	if args.Get(0) == nil {
+		return entities.MembershipResult{}, args.Error(1)
+	}
+	return args.Get(0).(entities.MembershipResult), args.Error(1)
+}
+
+func (m *repoMock) RenameTeam(ctx context.Context, name, newName string) (*entities.Team, error) {
+	args := m.Called(ctx, name, newName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Team), args.Error(1)
+}
+
+func (m *repoMock) GetTeamPolicy(ctx context.Context, teamName string) (*entities.TeamPolicy, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TeamPolicy), args.Error(1)
+}
+
+func (m *repoMock) SetTeamPolicy(ctx context.Context, policy entities.TeamPolicy) (*entities.TeamPolicy, error) {
+	args := m.Called(ctx, policy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TeamPolicy), args.Error(1)
+}
+
+func (m *repoMock) ListOwnershipRules(ctx context.Context) ([]entities.OwnershipRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.OwnershipRule), args.Error(1)
+}
+
+func (m *repoMock) ReplaceOwnershipRules(ctx context.Context, rules []entities.OwnershipRule) ([]entities.OwnershipRule, error) {
+	args := m.Called(ctx, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.OwnershipRule), args.Error(1)
+}
+
 func (m *repoMock) Stats(ctx context.Context) (entities.Stats, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -110,66 +397,795 @@ func (m *repoMock) PRStats(ctx context.Context, prID string) (entities.PRStats,
 	return args.Get(0).(entities.PRStats), args.Error(1)
 }
 
-func (m *repoMock) DeactivateTeam(ctx context.Context, teamName string) (entities.DeactivateResult, error) {
-	args := m.Called(ctx, teamName)
+func (m *repoMock) DeactivateTeam(ctx context.Context, teamName string, withDescendants bool, sel entities.ReviewerSelector) (entities.DeactivateResult, error) {
+	args := m.Called(ctx, teamName, withDescendants, sel)
 	if args.Get(0) == nil {
 		return entities.DeactivateResult{}, args.Error(1)
 	}
 	return args.Get(0).(entities.DeactivateResult), args.Error(1)
 }
 
-func TestUsecase_CreatePullRequestValidation(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
-
-	_, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{})
-	require.ErrorIs(t, err, entities.ErrInvalidArgument)
-	repo.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything)
+func (m *repoMock) AddWebhookSubscription(ctx context.Context, sub entities.WebhookSubscription) (*entities.WebhookSubscription, error) {
+	args := m.Called(ctx, sub)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.WebhookSubscription), args.Error(1)
 }
 
-func TestUsecase_CreatePullRequestDelegates(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
-
-	expected := &entities.PullRequest{ID: "1", Name: "demo", AuthorID: "a1"}
-	repo.On("CreatePR", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
-		return pr.ID == expected.ID
-	})).Return(expected, nil)
+func (m *repoMock) ListWebhookSubscriptions(ctx context.Context) ([]entities.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.WebhookSubscription), args.Error(1)
+}
 
-	pr, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{ID: "1", Name: "demo", AuthorID: "a1"})
-	require.NoError(t, err)
-	require.Equal(t, expected, pr)
-	repo.AssertExpectations(t)
+func (m *repoMock) DeleteWebhookSubscription(ctx context.Context, id int64) (*entities.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.WebhookSubscription), args.Error(1)
 }
 
-func TestUsecase_SetActiveUserValidation(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
+func (m *repoMock) ListWebhookDeliveries(ctx context.Context, filter entities.DeliveryFilter) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
 
-	_, err := uc.SetActiveUser(context.Background(), "", true)
-	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+func (m *repoMock) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
 }
 
-func TestUsecase_TeamGetValidation(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
+func (m *repoMock) RecordWebhookAttempt(ctx context.Context, attempt entities.WebhookAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
 
-	_, err := uc.Team(context.Background(), "")
-	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+func (m *repoMock) ListEvents(ctx context.Context, afterID int64, filter entities.EventFilter, limit int) ([]entities.Event, error) {
+	args := m.Called(ctx, afterID, filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.Event), args.Error(1)
 }
 
-func TestUsecase_ReviewerStatsValidation(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
+func (m *repoMock) LastEventID(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
 
-	_, err := uc.ReviewerStats(context.Background(), "", 0)
-	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+func (m *repoMock) PruneEvents(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
 }
 
-func TestUsecase_DeactivateValidation(t *testing.T) {
-	repo := &repoMock{}
-	uc := New(zap.NewNop().Sugar(), context.Background(), repo, time.Second)
+func (m *repoMock) ListCodeHostSyncs(ctx context.Context, filter entities.CodeHostSyncFilter) ([]entities.CodeHostSync, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.CodeHostSync), args.Error(1)
+}
+
+func (m *repoMock) ClaimCodeHostSyncs(ctx context.Context, limit int, lease time.Duration) ([]entities.CodeHostSync, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.CodeHostSync), args.Error(1)
+}
+
+func (m *repoMock) PruneCodeHostSyncs(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *repoMock) RecordCodeHostSync(ctx context.Context, attempt entities.CodeHostSyncAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func TestUsecase_CreatePullRequestValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_CreatePullRequestDelegates(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	expected := &entities.PullRequest{ID: "1", Name: "demo", AuthorID: "a1"}
+	repo.On("CreatePR", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
+		return pr.ID == expected.ID
+	}), mock.Anything).Return(expected, nil)
+
+	pr, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{ID: "1", Name: "demo", AuthorID: "a1"})
+	require.NoError(t, err)
+	require.Equal(t, expected, pr)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_SetActiveUserValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.SetActiveUser(context.Background(), "", true)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestUsecase_TeamGetValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.Team(context.Background(), "", false)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestUsecase_TeamHierarchy(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.CreateTeam(context.Background(), entities.Team{Name: "payments", Parent: "payments"})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.SetTeamParent(context.Background(), "", "engineering")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.SetTeamParent(context.Background(), "payments", "payments")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "CreateTeam", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "SetTeamParent", mock.Anything, mock.Anything, mock.Anything)
+
+	repo.On("GetTeam", mock.Anything, "engineering").Return(&entities.Team{Name: "engineering"}, nil).Twice()
+	team, err := uc.Team(context.Background(), "engineering", false)
+	require.NoError(t, err)
+	require.Nil(t, team.Descendants)
+	repo.AssertNotCalled(t, "ListTeamDescendants", mock.Anything, mock.Anything)
+
+	descendants := []entities.Team{{Name: "payments", Parent: "engineering"}, {Name: "billing", Parent: "payments"}}
+	repo.On("ListTeamDescendants", mock.Anything, "engineering").Return(descendants, nil).Once()
+	team, err = uc.Team(context.Background(), "engineering", true)
+	require.NoError(t, err)
+	require.Equal(t, descendants, team.Descendants)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ReviewerStatsValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.ReviewerStats(context.Background(), "", 0)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestUsecase_DeactivateValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.DeactivateTeam(context.Background(), "", true)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestUsecase_TeamMembershipValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.AddTeamMember(context.Background(), "backend", entities.User{}, false)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.AddTeamMember(context.Background(), "backend", entities.User{ID: "u1", Role: "boss"}, false)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.RemoveTeamMember(context.Background(), "", "u1")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.RenameTeam(context.Background(), "backend", "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	team := &entities.Team{Name: "backend"}
+	repo.On("GetTeam", mock.Anything, "backend").Return(team, nil).Once()
+	res, err := uc.RenameTeam(context.Background(), "backend", "backend")
+	require.NoError(t, err)
+	require.Equal(t, team, res)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "RenameTeam", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_SetTeamPolicyValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 0})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, SelectionMode: "fastest"})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"backend"}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"infra", "infra"}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, Merge: entities.MergePolicy{MinReviewers: -1}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.SetTeamPolicy(context.Background(), entities.TeamPolicy{TeamName: "backend", ReviewerCount: 2, Merge: entities.MergePolicy{MinAge: -time.Hour}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	repo.AssertNotCalled(t, "SetTeamPolicy", mock.Anything, mock.Anything)
+
+	policy := entities.TeamPolicy{TeamName: "backend", ReviewerCount: 3, SelectionMode: entities.SelectionLeastOpen}
+	repo.On("SetTeamPolicy", mock.Anything, policy).Return(&policy, nil)
+	res, err := uc.SetTeamPolicy(context.Background(), policy)
+	require.NoError(t, err)
+	require.Equal(t, &policy, res)
+}
+
+func TestUsecase_CreatePullRequestResolvesCodeOwners(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	repo.On("ListOwnershipRules", mock.Anything).Return([]entities.OwnershipRule{
+		{Pattern: "*", Owners: []entities.Owner{{Kind: entities.OwnerUser, ID: "u9"}}},
+		{Pattern: "/db/", Owners: []entities.Owner{{Kind: entities.OwnerTeam, ID: "dba"}}},
+	}, nil)
+	repo.On("CreatePR", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
+		return len(pr.CodeOwners) == 1 && pr.CodeOwners[0] == entities.Owner{Kind: entities.OwnerTeam, ID: "dba"}
+	}), mock.Anything).Return(&entities.PullRequest{ID: "1"}, nil)
+
+	_, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{
+		ID: "1", Name: "demo", AuthorID: "a1", ChangedFiles: []string{"db/migrations/001.sql"},
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ImportCodeownersValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.ImportCodeowners(context.Background(), "*.go owner@example.com")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "ReplaceOwnershipRules", mock.Anything, mock.Anything)
+}
+
+func TestUsecase_SetUserSkillsNormalizes(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	repo.On("SetUserSkills", mock.Anything, "u1", []string{"go", "sql"}).Return(&entities.User{ID: "u1", Skills: []string{"go", "sql"}}, nil)
+
+	usr, err := uc.SetUserSkills(context.Background(), "u1", []string{" SQL", "go", "Go"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "sql"}, usr.Skills)
+
+	_, err = uc.SetUserSkills(context.Background(), "u1", []string{"go", " "})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_AddUnavailabilityValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	start := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	_, err := uc.AddUnavailability(context.Background(), entities.Unavailability{UserID: "u1", StartsAt: start, EndsAt: start})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "AddUnavailability", mock.Anything, mock.Anything)
+}
+
+func TestUsecase_ReassignUnavailableReviews(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	repo.On("ClaimStartedUnavailability", mock.Anything).Return([]entities.Unavailability{{ID: 1, UserID: "u2"}}, nil)
+	repo.On("GetUserReviews", mock.Anything, "u2", "", entities.ReviewState("")).Return([]entities.PullRequestShort{
+		{ID: "pr-open", Status: entities.StatusOpen},
+		{ID: "pr-lonely", Status: entities.StatusOpen},
+		{ID: "pr-merged", Status: entities.StatusMerged},
+	}, nil)
+	repo.On("ReassignReviewer", mock.Anything, "pr-open", "u2", mock.Anything).Return(&entities.PullRequest{ID: "pr-open"}, "u3", nil)
+	repo.On("ReassignReviewer", mock.Anything, "pr-lonely", "u2", mock.Anything).Return(nil, "", entities.ErrNoCandidate)
+
+	n, err := uc.ReassignUnavailableReviews(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ReassignReviewer", mock.Anything, "pr-merged", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "ReleaseUnavailabilityClaim", mock.Anything, mock.Anything)
+}
+
+func TestUsecase_ReassignUnavailableReviewsReleasesFailedClaims(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	failure := errors.New("connection reset")
+	repo.On("ClaimStartedUnavailability", mock.Anything).Return([]entities.Unavailability{{ID: 1, UserID: "u2"}, {ID: 2, UserID: "u3"}}, nil)
+	repo.On("GetUserReviews", mock.Anything, "u2", "", entities.ReviewState("")).Return(nil, failure)
+	repo.On("GetUserReviews", mock.Anything, "u3", "", entities.ReviewState("")).Return([]entities.PullRequestShort{
+		{ID: "pr-open", Status: entities.StatusOpen},
+	}, nil)
+	repo.On("ReassignReviewer", mock.Anything, "pr-open", "u3", mock.Anything).Return(&entities.PullRequest{ID: "pr-open"}, "u4", nil)
+	repo.On("ReleaseUnavailabilityClaim", mock.Anything, int64(1)).Return(nil).Once()
+
+	n, err := uc.ReassignUnavailableReviews(context.Background())
+	require.ErrorIs(t, err, failure)
+	require.Equal(t, 1, n)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ReleaseUnavailabilityClaim", mock.Anything, int64(2))
+}
+
+func TestUsecase_SetUserScheduleValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	schedule := entities.DefaultWorkSchedule()
+	schedule.TimeZone = "Mars/Olympus_Mons"
+	_, err := uc.SetUserSchedule(context.Background(), "u1", schedule)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	schedule = entities.DefaultWorkSchedule()
+	schedule.Days = nil
+	_, err = uc.SetUserSchedule(context.Background(), "u1", schedule)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "SetUserSchedule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_ReviewerStatsLocalTime(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	schedule := entities.DefaultWorkSchedule()
+	schedule.TimeZone = "Asia/Tokyo"
+	repo.On("ReviewerStats", mock.Anything, "u1", 10).Return(entities.ReviewerStats{UserID: "u1", Schedule: schedule}, nil)
+
+	res, err := uc.ReviewerStats(context.Background(), "u1", 0)
+	require.NoError(t, err)
+	require.NotNil(t, res.LocalTime)
+	_, offset := res.LocalTime.Zone()
+	require.Equal(t, 9*3600, offset)
+}
+
+func TestUsecase_SetUserCapacityValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	zero := 0
+	_, err := uc.SetUserCapacity(context.Background(), "u1", entities.ReviewCapacity{MaxOpenReviews: &zero, Weight: 1})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.SetUserCapacity(context.Background(), "u1", entities.ReviewCapacity{Weight: 0})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.SetUserCapacity(context.Background(), "u1", entities.ReviewCapacity{Weight: entities.MaxReviewWeight + 1})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "SetUserCapacity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_ReviewerStatsCapacityUsage(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	maxOpen := 4
+	capacity := entities.ReviewCapacity{MaxOpenReviews: &maxOpen, Weight: 0.5}
+	repo.On("ReviewerStats", mock.Anything, "u1", 10).Return(entities.ReviewerStats{UserID: "u1", OpenPRCnt: 4, Capacity: capacity}, nil)
+	repo.On("ReviewerStats", mock.Anything, "u2", 10).Return(entities.ReviewerStats{UserID: "u2", OpenPRCnt: 3, Capacity: entities.DefaultReviewCapacity()}, nil)
+
+	res, err := uc.ReviewerStats(context.Background(), "u1", 0)
+	require.NoError(t, err)
+	require.NotNil(t, res.CapacityUsage)
+	require.InDelta(t, 1.0, *res.CapacityUsage, 1e-9)
+	require.True(t, res.AtCapacity)
+
+	res, err = uc.ReviewerStats(context.Background(), "u2", 0)
+	require.NoError(t, err)
+	require.Nil(t, res.CapacityUsage)
+	require.False(t, res.AtCapacity)
+}
+
+func TestUsecase_PreviewAssignment(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.PreviewAssignment(context.Background(), entities.PullRequest{})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	expected := &entities.AssignmentPreview{Reviewers: []string{"u2"}}
+	repo.On("PreviewAssignment", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
+		return pr.AuthorID == "u1" && len(pr.Labels) == 1 && pr.Labels[0] == "go"
+	}), mock.Anything).Return(expected, nil)
+
+	preview, err := uc.PreviewAssignment(context.Background(), entities.PullRequest{AuthorID: "u1", Labels: []string{" Go ", "go"}})
+	require.NoError(t, err)
+	require.Equal(t, expected, preview)
+	repo.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_AddPairingRuleValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.AddPairingRule(context.Background(), entities.PairingRule{Kind: "mentor", AuthorID: "u1", ReviewerID: "u2"})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.AddPairingRule(context.Background(), entities.PairingRule{Kind: entities.PairingBlock, AuthorID: "u1"})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.AddPairingRule(context.Background(), entities.PairingRule{Kind: entities.PairingRequire, AuthorID: "u1", ReviewerID: "u1"})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "AddPairingRule", mock.Anything, mock.Anything)
+
+	rule := entities.PairingRule{Kind: entities.PairingRequire, AuthorID: "u1", ReviewerID: "u2"}
+	stored := rule
+	stored.ID = 1
+	repo.On("AddPairingRule", mock.Anything, rule).Return(&stored, nil)
+	res, err := uc.AddPairingRule(context.Background(), rule)
+	require.NoError(t, err)
+	require.Equal(t, &stored, res)
+}
+
+func TestUsecase_SetUserRoleValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.SetUserRole(context.Background(), "", entities.RoleSenior)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.SetUserRole(context.Background(), "u1", "principal")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.CreateTeam(context.Background(), entities.Team{Name: "backend", Members: []entities.User{{ID: "u1", Role: "intern"}}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "SetUserRole", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "CreateTeam", mock.Anything, mock.Anything)
+
+	repo.On("SetUserRole", mock.Anything, "u1", entities.RoleLead).Return(&entities.User{ID: "u1", Role: entities.RoleLead}, nil)
+	res, err := uc.SetUserRole(context.Background(), "u1", entities.RoleLead)
+	require.NoError(t, err)
+	require.Equal(t, entities.RoleLead, res.Role)
+}
+
+func TestUsecase_ReassignPullRequestTargeted(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, _, err := uc.ReassignPullRequest(context.Background(), "pr-1", "u2", "u2")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "ReplaceReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	expected := &entities.PullRequest{ID: "pr-1", Reviewers: []string{"u3", "u5"}}
+	repo.On("ReplaceReviewer", mock.Anything, "pr-1", "u2", "u5").Return(expected, nil)
+	pr, repl, err := uc.ReassignPullRequest(context.Background(), "pr-1", "u2", "u5")
+	require.NoError(t, err)
+	require.Equal(t, expected, pr)
+	require.Equal(t, "u5", repl)
+	repo.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_AddRemoveReviewerValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.AddReviewer(context.Background(), "pr-1", "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.RemoveReviewer(context.Background(), "", "u2")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "AddReviewer", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "RemoveReviewer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_SubmitReviewValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, _, err := uc.SubmitReview(context.Background(), "pr-1", "", entities.ReviewApproved)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, _, err = uc.SubmitReview(context.Background(), "pr-1", "u2", entities.ReviewPending)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, _, err = uc.SubmitReview(context.Background(), "pr-1", "u2", "lgtm")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.GetReviewList(context.Background(), "u2", "", "lgtm")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "SubmitReview", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "GetUserReviews", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_SubmitReviewDeclineReplaces(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	// The decline and its replacement happen in a single repository call.
+	replaced := &entities.PullRequest{ID: "pr-1", Reviewers: []string{"u3"}}
+	repo.On("SubmitReview", mock.Anything, "pr-1", "u2", entities.ReviewDeclined, mock.Anything).Return(replaced, "u3", nil).Once()
+
+	pr, repl, err := uc.SubmitReview(context.Background(), "pr-1", "u2", entities.ReviewDeclined)
+	require.NoError(t, err)
+	require.Equal(t, "u3", repl)
+	require.Equal(t, replaced, pr)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_PRLifecycleValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.CreatePullRequest(context.Background(), entities.PullRequest{ID: "pr-1", Name: "demo", AuthorID: "a1", Status: entities.StatusClosed})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.ReadyPullRequest(context.Background(), "", nil)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.ClosePullRequest(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.ReopenPullRequest(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	status := entities.PullRequestStatus("ABANDONED")
+	_, err = uc.SummaryStats(context.Background(), entities.StatsFilter{Status: &status})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "MarkPRReady", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "StatsSummary", mock.Anything, mock.Anything)
+}
+
+func TestUsecase_ReadyPullRequestDelegates(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	expected := &entities.PullRequest{ID: "pr-1", Status: entities.StatusOpen, Reviewers: []string{"u2"}}
+	repo.On("MarkPRReady", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
+		return pr.ID == "pr-1" && len(pr.CodeOwners) == 0
+	}), mock.Anything).Return(expected, nil)
+
+	pr, err := uc.ReadyPullRequest(context.Background(), "pr-1", nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, pr)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ReassignStaleReviews(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.ReassignStaleReviews(context.Background(), 0)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "ListStaleReviews", mock.Anything, mock.Anything)
+
+	sla := 24 * time.Hour
+	repo.On("ListStaleReviews", mock.Anything, sla).Return([]entities.StaleReview{
+		{PRID: "pr-1", ReviewerID: "u2"},
+		{PRID: "pr-2", ReviewerID: "u2"},
+		{PRID: "pr-3", ReviewerID: "u3"},
+	}, nil)
+	repo.On("ReassignStaleReview", mock.Anything, "pr-1", "u2", sla, mock.Anything).Return(&entities.PullRequest{ID: "pr-1"}, "u4", nil)
+	repo.On("ReassignStaleReview", mock.Anything, "pr-2", "u2", sla, mock.Anything).Return(nil, "", entities.ErrNoCandidate)
+	repo.On("ReassignStaleReview", mock.Anything, "pr-3", "u3", sla, mock.Anything).Return(nil, "", entities.ErrReviewNotStale)
+
+	n, err := uc.ReassignStaleReviews(context.Background(), sla)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	repo.AssertExpectations(t)
+
+	// A failed review does not stop the sweep.
+	repo = &repoMock{}
+	uc = New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+	failure := errors.New("connection reset")
+	repo.On("ListStaleReviews", mock.Anything, sla).Return([]entities.StaleReview{
+		{PRID: "pr-1", ReviewerID: "u2"},
+		{PRID: "pr-2", ReviewerID: "u3"},
+	}, nil)
+	repo.On("ReassignStaleReview", mock.Anything, "pr-1", "u2", sla, mock.Anything).Return(nil, "", failure)
+	repo.On("ReassignStaleReview", mock.Anything, "pr-2", "u3", sla, mock.Anything).Return(&entities.PullRequest{ID: "pr-2"}, "u4", nil)
+
+	n, err = uc.ReassignStaleReviews(context.Background(), sla)
+	require.ErrorIs(t, err, failure)
+	require.Equal(t, 1, n)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_AddWebhookValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	events := []entities.WebhookEvent{entities.EventPRCreated}
+	for _, sub := range []entities.WebhookSubscription{
+		{URL: "ftp://hooks.example.com", Secret: "s", Events: events},
+		{URL: "/relative", Secret: "s", Events: events},
+		{URL: "https://hooks.example.com", Events: events},
+		{URL: "https://hooks.example.com", Secret: "s"},
+		{URL: "https://hooks.example.com", Secret: "s", Events: []entities.WebhookEvent{"pr.deleted"}},
+	} {
+		_, err := uc.AddWebhook(context.Background(), sub)
+		require.ErrorIs(t, err, entities.ErrInvalidArgument, "%+v", sub)
+	}
+	repo.AssertNotCalled(t, "AddWebhookSubscription", mock.Anything, mock.Anything)
+
+	want := entities.WebhookSubscription{
+		URL:    "https://hooks.example.com/reviewers",
+		Secret: "s",
+		Events: []entities.WebhookEvent{entities.EventPRCreated, entities.EventPRMerged},
+	}
+	repo.On("AddWebhookSubscription", mock.Anything, want).Return(&entities.WebhookSubscription{ID: 1}, nil)
+	_, err := uc.AddWebhook(context.Background(), entities.WebhookSubscription{
+		URL:    " https://hooks.example.com/reviewers ",
+		Secret: "s",
+		Events: []entities.WebhookEvent{entities.EventPRCreated, entities.EventPRMerged, entities.EventPRCreated},
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_WebhookDeliveriesFilter(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	bad := entities.DeliveryStatus("lost")
+	_, err := uc.WebhookDeliveries(context.Background(), entities.DeliveryFilter{Status: &bad})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	repo.On("ListWebhookDeliveries", mock.Anything, entities.DeliveryFilter{Limit: 50}).Return([]entities.WebhookDelivery{}, nil)
+	repo.On("ListWebhookDeliveries", mock.Anything, entities.DeliveryFilter{Limit: 500}).Return([]entities.WebhookDelivery{}, nil)
+	_, err = uc.WebhookDeliveries(context.Background(), entities.DeliveryFilter{})
+	require.NoError(t, err)
+	_, err = uc.WebhookDeliveries(context.Background(), entities.DeliveryFilter{Limit: 10000})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_SubscribeEventsValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.SubscribeEvents(context.Background(), entities.EventFilter{}, -1)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "LastEventID", mock.Anything)
+}
+
+func TestUsecase_PruneEvents(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.PruneEvents(context.Background(), 0)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "PruneEvents", mock.Anything, mock.Anything)
+
+	start := time.Now()
+	repo.On("PruneEvents", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return !before.Before(start.Add(-time.Hour)) && before.Before(start)
+	})).Return(int64(3), nil)
+	n, err := uc.PruneEvents(context.Background(), time.Hour)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, n)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_PruneCodeHostSyncs(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	_, err := uc.PruneCodeHostSyncs(context.Background(), 0)
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "PruneCodeHostSyncs", mock.Anything, mock.Anything)
+
+	start := time.Now()
+	repo.On("PruneCodeHostSyncs", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return !before.Before(start.Add(-time.Hour)) && before.Before(start)
+	})).Return(int64(2), nil)
+	n, err := uc.PruneCodeHostSyncs(context.Background(), time.Hour)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ApplyCodeHostEvent(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	opened := entities.CodeHostEvent{
+		Action: entities.CodeHostOpened,
+		PR:     entities.PullRequest{ID: "octo/app#7", Name: "Add cache", AuthorID: "u1", Status: entities.StatusDraft},
+	}
+	repo.On("CreatePR", mock.Anything, mock.MatchedBy(func(pr entities.PullRequest) bool {
+		return pr.ID == "octo/app#7" && pr.Status == entities.StatusDraft
+	}), mock.Anything).Return(nil, entities.ErrPRExists).Once()
+	pr, err := uc.ApplyCodeHostEvent(context.Background(), opened)
+	require.NoError(t, err)
+	require.Nil(t, pr)
+
+	merged := &entities.PullRequest{ID: "octo/app#7", Status: entities.StatusMerged}
+	// The merge already happened on the code host, so it bypasses the merge policy.
+	repo.On("RecordPRMerge", mock.Anything, "octo/app#7").Return(merged, nil).Once()
+	pr, err = uc.ApplyCodeHostEvent(context.Background(), entities.CodeHostEvent{Action: entities.CodeHostMerged, PR: entities.PullRequest{ID: "octo/app#7"}})
+	require.NoError(t, err)
+	require.Equal(t, merged, pr)
+	repo.AssertNotCalled(t, "MergePR", mock.Anything, mock.Anything)
+
+	_, err = uc.ApplyCodeHostEvent(context.Background(), entities.CodeHostEvent{Action: "edited", PR: entities.PullRequest{ID: "octo/app#7"}})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_CodeHostSyncsFilter(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	unknown := entities.CodeHostSyncStatus("lost")
+	_, err := uc.CodeHostSyncs(context.Background(), entities.CodeHostSyncFilter{Status: &unknown})
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	failed := entities.SyncFailed
+	repo.On("ListCodeHostSyncs", mock.Anything, entities.CodeHostSyncFilter{Status: &failed, Limit: 500}).
+		Return([]entities.CodeHostSync{{PRID: "octo/app#7", Status: entities.SyncFailed}}, nil).Once()
+	syncs, err := uc.CodeHostSyncs(context.Background(), entities.CodeHostSyncFilter{Status: &failed, Limit: 10000})
+	require.NoError(t, err)
+	require.Len(t, syncs, 1)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ListPagination(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
 
-	_, err := uc.DeactivateTeam(context.Background(), "")
+	repo.On("ListUsers", mock.Anything, entities.UserFilter{TeamName: "backend", Limit: 3}).
+		Return([]entities.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}}, nil).Once()
+	page, err := uc.Users(context.Background(), entities.UserFilter{TeamName: "backend", Limit: 2}, "")
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.NotEmpty(t, page.NextCursor)
+
+	repo.On("ListUsers", mock.Anything, entities.UserFilter{TeamName: "backend", AfterID: "u2", Limit: 3}).
+		Return([]entities.User{{ID: "u3"}}, nil).Once()
+	page, err = uc.Users(context.Background(), entities.UserFilter{TeamName: "backend", Limit: 2}, page.NextCursor)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Empty(t, page.NextCursor)
+
+	created := time.Date(2026, 1, 20, 10, 0, 0, 123000, time.UTC)
+	repo.On("ListPRs", mock.Anything, entities.PullRequestFilter{Limit: 2}).
+		Return([]entities.PullRequest{{ID: "pr-2", CreatedAt: &created}, {ID: "pr-1", CreatedAt: &created}}, nil).Once()
+	prs, err := uc.PullRequests(context.Background(), entities.PullRequestFilter{Limit: 1}, "")
+	require.NoError(t, err)
+	require.Len(t, prs.Items, 1)
+
+	after := &entities.PullRequestCursor{CreatedAt: created, ID: "pr-2"}
+	repo.On("ListPRs", mock.Anything, entities.PullRequestFilter{After: after, Limit: maxPageLimit + 1}).
+		Return([]entities.PullRequest{}, nil).Once()
+	prs, err = uc.PullRequests(context.Background(), entities.PullRequestFilter{Limit: 1000}, prs.NextCursor)
+	require.NoError(t, err)
+	require.Empty(t, prs.NextCursor)
+
+	repo.On("ListTeams", mock.Anything, entities.TeamFilter{Query: "back", Limit: defaultPageLimit + 1}).
+		Return([]entities.TeamSummary{{Name: "backend", Members: 3}}, nil).Once()
+	teams, err := uc.Teams(context.Background(), entities.TeamFilter{Query: " back "}, "")
+	require.NoError(t, err)
+	require.Len(t, teams.Items, 1)
+	repo.AssertExpectations(t)
+}
+
+func TestUsecase_ListValidation(t *testing.T) {
+	repo := &repoMock{}
+	uc := New(zap.NewNop().Sugar(), context.Background(), repo, selector.NewRandom(), time.Second)
+
+	status := entities.PullRequestStatus("PENDING")
+	_, err := uc.PullRequests(context.Background(), entities.PullRequestFilter{Status: &status}, "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	from, to := time.Now(), time.Now().Add(-time.Hour)
+	_, err = uc.PullRequests(context.Background(), entities.PullRequestFilter{CreatedFrom: &from, CreatedTo: &to}, "")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	_, err = uc.PullRequests(context.Background(), entities.PullRequestFilter{}, "not a cursor")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	// A user cursor has no creation time to resume the PR listing from.
+	_, err = uc.PullRequests(context.Background(), entities.PullRequestFilter{}, encodeCursor(listCursor{Key: "u1"}))
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.Teams(context.Background(), entities.TeamFilter{}, "e30")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = uc.PullRequest(context.Background(), "")
 	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	repo.AssertNotCalled(t, "ListPRs", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "ListTeams", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "GetPR", mock.Anything, mock.Anything)
 }
@@ -0,0 +1,74 @@
+// Package domain contains application Usecases orchestrating domain logic by the event stream.
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// SubscribeEvents subscribes to domain events matching filter, first replaying those after
+// lastEventID when it is positive.
+func (u *Usecase) SubscribeEvents(ctx context.Context, filter entities.EventFilter, lastEventID int64) (entities.EventSubscription, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if lastEventID < 0 {
+		u.log.Errorw("failed to subscribe to events: invalid last event id", "last_event_id", lastEventID)
+		return nil, fmt.Errorf("%w: last event id must not be negative", entities.ErrInvalidArgument)
+	}
+	sub, err := u.events.Subscribe(ctx, filter, lastEventID)
+	if err != nil {
+		u.log.Errorw("failed to subscribe to events", "error", err)
+		return nil, err
+	}
+	return sub, nil
+}
+
+// RunEventStream feeds subscribers with new events, polling the event log every interval until ctx is done.
+func (u *Usecase) RunEventStream(ctx context.Context, interval, gapTimeout time.Duration) {
+	u.events.Run(ctx, interval, gapTimeout)
+}
+
+// DisableEventStream makes subscriptions fail with ErrStreamDisabled; it replaces RunEventStream
+// when the stream is turned off.
+func (u *Usecase) DisableEventStream() {
+	u.events.Disable()
+}
+
+// PruneEvents deletes logged events older than retention, which can no longer be replayed.
+// Events whose webhook deliveries are still pending are kept. It returns the number of deleted events.
+func (u *Usecase) PruneEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if retention <= 0 {
+		u.log.Errorw("failed to prune events: invalid retention", "retention", retention)
+		return 0, fmt.Errorf("%w: retention must be positive", entities.ErrInvalidArgument)
+	}
+	return u.repo.PruneEvents(ctx, time.Now().Add(-retention))
+}
+
+// RunEventPruner periodically deletes events older than retention until ctx is done.
+func (u *Usecase) RunEventPruner(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := u.PruneEvents(ctx, retention)
+			if err != nil {
+				u.log.Errorw("event pruner run failed", "error", err)
+				continue
+			}
+			if n > 0 {
+				u.log.Infow("old events pruned", "deleted", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+// listCursor is the keyset position behind the opaque cursors of list endpoints.
+type listCursor struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Key       string     `json:"key"`
+}
+
+func encodeCursor(c listCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(raw, &c)
+	}
+	if err != nil || c.Key == "" {
+		return c, fmt.Errorf("%w: invalid cursor", entities.ErrInvalidArgument)
+	}
+	return c, nil
+}
+
+// pageLimit applies the default and the cap of list endpoints.
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	return min(limit, maxPageLimit)
+}
+
+// paginate drops the extra item fetched beyond limit to tell whether a next page exists
+// and points the next cursor at the last item kept.
+func paginate[T any](items []T, limit int, cursor func(T) listCursor) entities.Page[T] {
+	if len(items) <= limit {
+		return entities.Page[T]{Items: items}
+	}
+	items = items[:limit]
+	return entities.Page[T]{Items: items, NextCursor: encodeCursor(cursor(items[limit-1]))}
+}
+
+// PullRequest returns a PR with its reviewers, reviews, labels and fallback teams.
+func (u *Usecase) PullRequest(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" {
+		u.log.Errorw("failed to get pr: missing pull_request_id")
+		return nil, fmt.Errorf("%w: pull_request_id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.GetPR(ctx, prID)
+}
+
+// PullRequests returns a page of PRs matching filter, newest first, resuming after cursor when it is set;
+// the limit defaults to 50 and is capped at 500.
+func (u *Usecase) PullRequests(ctx context.Context, filter entities.PullRequestFilter, cursor string) (entities.Page[entities.PullRequest], error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		u.log.Errorw("failed to list prs: unknown status", "status", *filter.Status)
+		return entities.Page[entities.PullRequest]{}, fmt.Errorf("%w: unknown status %q", entities.ErrInvalidArgument, *filter.Status)
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		u.log.Errorw("failed to list prs: empty created_at range", "from", filter.CreatedFrom, "to", filter.CreatedTo)
+		return entities.Page[entities.PullRequest]{}, fmt.Errorf("%w: created_from must be before created_to", entities.ErrInvalidArgument)
+	}
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err == nil && c.CreatedAt == nil {
+			err = fmt.Errorf("%w: invalid cursor", entities.ErrInvalidArgument)
+		}
+		if err != nil {
+			u.log.Errorw("failed to list prs: invalid cursor", "cursor", cursor)
+			return entities.Page[entities.PullRequest]{}, err
+		}
+		filter.After = &entities.PullRequestCursor{CreatedAt: *c.CreatedAt, ID: c.Key}
+	}
+	limit := pageLimit(filter.Limit)
+	filter.Limit = limit + 1
+
+	prs, err := u.repo.ListPRs(ctx, filter)
+	if err != nil {
+		return entities.Page[entities.PullRequest]{}, err
+	}
+	return paginate(prs, limit, func(pr entities.PullRequest) listCursor {
+		return listCursor{CreatedAt: pr.CreatedAt, Key: pr.ID}
+	}), nil
+}
+
+// Users returns a page of users matching filter ordered by ID, resuming after cursor when it is set;
+// the limit defaults to 50 and is capped at 500.
+func (u *Usecase) Users(ctx context.Context, filter entities.UserFilter, cursor string) (entities.Page[entities.User], error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			u.log.Errorw("failed to list users: invalid cursor", "cursor", cursor)
+			return entities.Page[entities.User]{}, err
+		}
+		filter.AfterID = c.Key
+	}
+	limit := pageLimit(filter.Limit)
+	filter.Limit = limit + 1
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	users, err := u.repo.ListUsers(ctx, filter)
+	if err != nil {
+		return entities.Page[entities.User]{}, err
+	}
+	return paginate(users, limit, func(user entities.User) listCursor {
+		return listCursor{Key: user.ID}
+	}), nil
+}
+
+// Teams returns a page of teams matching filter ordered by name, resuming after cursor when it is set;
+// the limit defaults to 50 and is capped at 500.
+func (u *Usecase) Teams(ctx context.Context, filter entities.TeamFilter, cursor string) (entities.Page[entities.TeamSummary], error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			u.log.Errorw("failed to list teams: invalid cursor", "cursor", cursor)
+			return entities.Page[entities.TeamSummary]{}, err
+		}
+		filter.AfterName = c.Key
+	}
+	limit := pageLimit(filter.Limit)
+	filter.Limit = limit + 1
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	teams, err := u.repo.ListTeams(ctx, filter)
+	if err != nil {
+		return entities.Page[entities.TeamSummary]{}, err
+	}
+	return paginate(teams, limit, func(t entities.TeamSummary) listCursor {
+		return listCursor{Key: t.Name}
+	}), nil
+}
@@ -0,0 +1,55 @@
+// Package domain contains application Usecases orchestrating domain logic by code ownership.
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/usecase/ownership"
+)
+
+// OwnershipRules returns code ownership rules in evaluation order.
+func (u *Usecase) OwnershipRules(ctx context.Context) ([]entities.OwnershipRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	return u.repo.ListOwnershipRules(ctx)
+}
+
+// SetOwnershipRules validates and replaces the whole rule set.
+func (u *Usecase) SetOwnershipRules(ctx context.Context, rules []entities.OwnershipRule) ([]entities.OwnershipRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if err := ownership.ValidateRules(rules); err != nil {
+		u.log.Errorw("failed to set ownership rules", "error", err)
+		return nil, err
+	}
+	return u.repo.ReplaceOwnershipRules(ctx, rules)
+}
+
+// ImportCodeowners replaces ownership rules with the ones parsed from CODEOWNERS content.
+func (u *Usecase) ImportCodeowners(ctx context.Context, content string) ([]entities.OwnershipRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	rules, err := ownership.ParseCodeowners(content)
+	if err != nil {
+		u.log.Errorw("failed to parse codeowners", "error", err)
+		return nil, err
+	}
+	return u.repo.ReplaceOwnershipRules(ctx, rules)
+}
+
+func (u *Usecase) codeOwners(ctx context.Context, files []string) ([]entities.Owner, error) {
+	rules, err := u.repo.ListOwnershipRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matcher, err := ownership.NewMatcher(rules)
+	if err != nil {
+		return nil, fmt.Errorf("compile ownership rules: %w", err)
+	}
+	return matcher.Owners(files), nil
+}
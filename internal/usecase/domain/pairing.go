@@ -0,0 +1,49 @@
+// Package domain contains application Usecases orchestrating domain logic by pairing rules.
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// AddPairingRule validates and stores an author/reviewer pairing rule.
+func (u *Usecase) AddPairingRule(ctx context.Context, rule entities.PairingRule) (*entities.PairingRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !rule.Kind.IsValid() {
+		u.log.Errorw("failed to add pairing rule: unknown kind", "kind", rule.Kind)
+		return nil, fmt.Errorf("%w: kind must be %q or %q", entities.ErrInvalidArgument, entities.PairingBlock, entities.PairingRequire)
+	}
+	if rule.AuthorID == "" || rule.ReviewerID == "" {
+		u.log.Errorw("failed to add pairing rule: missing user", "author_id", rule.AuthorID, "reviewer_id", rule.ReviewerID)
+		return nil, fmt.Errorf("%w: author_id and reviewer_id are required", entities.ErrInvalidArgument)
+	}
+	if rule.AuthorID == rule.ReviewerID {
+		u.log.Errorw("failed to add pairing rule: author is the reviewer", "user_id", rule.AuthorID)
+		return nil, fmt.Errorf("%w: author_id and reviewer_id must differ", entities.ErrInvalidArgument)
+	}
+	return u.repo.AddPairingRule(ctx, rule)
+}
+
+// PairingRules returns pairing rules mentioning userID, or all rules when userID is empty.
+func (u *Usecase) PairingRules(ctx context.Context, userID string) ([]entities.PairingRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	return u.repo.ListPairingRules(ctx, userID)
+}
+
+// DeletePairingRule removes a pairing rule.
+func (u *Usecase) DeletePairingRule(ctx context.Context, id int64) (*entities.PairingRule, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if id <= 0 {
+		u.log.Errorw("failed to delete pairing rule: invalid id", "id", id)
+		return nil, fmt.Errorf("%w: id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.DeletePairingRule(ctx, id)
+}
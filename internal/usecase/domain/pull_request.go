@@ -3,12 +3,13 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"assigning-reviewers-for-pr/internal/entities"
 )
 
-// CreatePullRequest creates PR and auto-assigns reviewers.
+// CreatePullRequest creates PR and auto-assigns reviewers unless it is a draft.
 func (u *Usecase) CreatePullRequest(ctx context.Context, pr entities.PullRequest) (*entities.PullRequest, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
@@ -17,7 +18,16 @@ func (u *Usecase) CreatePullRequest(ctx context.Context, pr entities.PullRequest
 		u.log.Errorw("failed to create the pull request", "pr", pr)
 		return nil, fmt.Errorf("%w: missing required fields", entities.ErrInvalidArgument)
 	}
-	res, err := u.repo.CreatePR(ctx, pr)
+	if pr.Status != "" && pr.Status != entities.StatusOpen && pr.Status != entities.StatusDraft {
+		u.log.Errorw("failed to create the pull request: invalid initial status", "pr_id", pr.ID, "status", pr.Status)
+		return nil, fmt.Errorf("%w: a new PR is either OPEN or DRAFT", entities.ErrInvalidArgument)
+	}
+	pr, err := u.prepareAssignment(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.CreatePR(ctx, pr, u.selector)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +35,40 @@ func (u *Usecase) CreatePullRequest(ctx context.Context, pr entities.PullRequest
 	return res, nil
 }
 
+// PreviewAssignment shows who CreatePullRequest would assign to pr and why others are left out, without writing anything.
+func (u *Usecase) PreviewAssignment(ctx context.Context, pr entities.PullRequest) (*entities.AssignmentPreview, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if pr.AuthorID == "" {
+		u.log.Errorw("failed to preview assignment: missing author_id")
+		return nil, fmt.Errorf("%w: author_id is required", entities.ErrInvalidArgument)
+	}
+	pr, err := u.prepareAssignment(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.PreviewAssignment(ctx, pr, u.selector)
+}
+
+// prepareAssignment normalizes labels and resolves code owners of the changed files.
+func (u *Usecase) prepareAssignment(ctx context.Context, pr entities.PullRequest) (entities.PullRequest, error) {
+	labels, err := normalizeTags(pr.Labels)
+	if err != nil {
+		u.log.Errorw("failed to prepare assignment: invalid labels", "pr_id", pr.ID, "error", err)
+		return pr, err
+	}
+	pr.Labels = labels
+	if len(pr.ChangedFiles) > 0 {
+		owners, err := u.codeOwners(ctx, pr.ChangedFiles)
+		if err != nil {
+			return pr, err
+		}
+		pr.CodeOwners = owners
+	}
+	return pr, nil
+}
+
 // MergePullRequest marks PR as merged idempotently.
 func (u *Usecase) MergePullRequest(ctx context.Context, prID string) (*entities.PullRequest, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
@@ -37,8 +81,49 @@ func (u *Usecase) MergePullRequest(ctx context.Context, prID string) (*entities.
 	return u.repo.MergePR(ctx, prID)
 }
 
-// ReassignPullRequest swaps reviewer.
-func (u *Usecase) ReassignPullRequest(ctx context.Context, prID, oldUserID string) (*entities.PullRequest, string, error) {
+// ReadyPullRequest marks a draft PR ready for review and assigns its reviewers,
+// routing to the owners of changedFiles like CreatePullRequest.
+func (u *Usecase) ReadyPullRequest(ctx context.Context, prID string, changedFiles []string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" {
+		u.log.Errorw("failed to mark the pull request ready: missing prID")
+		return nil, fmt.Errorf("%w: pull_request_id is required", entities.ErrInvalidArgument)
+	}
+	pr, err := u.prepareAssignment(ctx, entities.PullRequest{ID: prID, ChangedFiles: changedFiles})
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.MarkPRReady(ctx, pr, u.selector)
+}
+
+// ClosePullRequest abandons a draft or open PR idempotently.
+func (u *Usecase) ClosePullRequest(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" {
+		u.log.Errorw("failed to close the pull request: missing prID")
+		return nil, fmt.Errorf("%w: pull_request_id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.ClosePR(ctx, prID)
+}
+
+// ReopenPullRequest brings a closed PR back idempotently.
+func (u *Usecase) ReopenPullRequest(ctx context.Context, prID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" {
+		u.log.Errorw("failed to reopen the pull request: missing prID")
+		return nil, fmt.Errorf("%w: pull_request_id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.ReopenPR(ctx, prID, u.selector)
+}
+
+// ReassignPullRequest swaps reviewer for newUserID, or for one chosen by the selector when newUserID is empty.
+func (u *Usecase) ReassignPullRequest(ctx context.Context, prID, oldUserID, newUserID string) (*entities.PullRequest, string, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
 
@@ -46,5 +131,66 @@ func (u *Usecase) ReassignPullRequest(ctx context.Context, prID, oldUserID strin
 		u.log.Errorw("failed to reassign reviewer: missing required fields", "pr_id", prID, "old_user_id", oldUserID)
 		return nil, "", fmt.Errorf("%w: missing required fields", entities.ErrInvalidArgument)
 	}
-	return u.repo.ReassignReviewer(ctx, prID, oldUserID)
+	if newUserID == "" {
+		return u.repo.ReassignReviewer(ctx, prID, oldUserID, u.selector)
+	}
+	if newUserID == oldUserID {
+		u.log.Errorw("failed to reassign reviewer: new reviewer equals old one", "pr_id", prID, "user_id", oldUserID)
+		return nil, "", fmt.Errorf("%w: new_user_id must differ from old_user_id", entities.ErrInvalidArgument)
+	}
+	pr, err := u.repo.ReplaceReviewer(ctx, prID, oldUserID, newUserID)
+	if err != nil {
+		return nil, "", err
+	}
+	return pr, newUserID, nil
+}
+
+// AddReviewer assigns userID to the PR in addition to its current reviewers.
+func (u *Usecase) AddReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" || userID == "" {
+		u.log.Errorw("failed to add reviewer: missing required fields", "pr_id", prID, "user_id", userID)
+		return nil, fmt.Errorf("%w: missing required fields", entities.ErrInvalidArgument)
+	}
+	return u.repo.AddReviewer(ctx, prID, userID)
+}
+
+// RemoveReviewer unassigns userID from the PR without a replacement.
+func (u *Usecase) RemoveReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" || userID == "" {
+		u.log.Errorw("failed to remove reviewer: missing required fields", "pr_id", prID, "user_id", userID)
+		return nil, fmt.Errorf("%w: missing required fields", entities.ErrInvalidArgument)
+	}
+	return u.repo.RemoveReviewer(ctx, prID, userID)
+}
+
+// SubmitReview records the decision of reviewer userID on the PR. A declined review is handed over
+// to a replacement chosen by the selector, returned as the second value; when nobody can take it
+// over the declined reviewer stays assigned.
+func (u *Usecase) SubmitReview(ctx context.Context, prID, userID string, state entities.ReviewState) (*entities.PullRequest, string, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if prID == "" || userID == "" {
+		u.log.Errorw("failed to submit review: missing required fields", "pr_id", prID, "user_id", userID)
+		return nil, "", fmt.Errorf("%w: missing required fields", entities.ErrInvalidArgument)
+	}
+	if !state.IsDecision() {
+		u.log.Errorw("failed to submit review: unknown decision", "pr_id", prID, "user_id", userID, "state", state)
+		return nil, "", fmt.Errorf("%w: state must be approved, changes_requested or declined", entities.ErrInvalidArgument)
+	}
+	return u.repo.SubmitReview(ctx, prID, userID, state, u.selector)
+}
+
+// keepsReviewer reports whether a failed automatic reassignment should leave the reviewer in place
+// rather than fail the whole operation.
+func keepsReviewer(err error) bool {
+	return errors.Is(err, entities.ErrNoCandidate) || errors.Is(err, entities.ErrPRMerged) || errors.Is(err, entities.ErrInvalidStatus) ||
+		errors.Is(err, entities.ErrNotAssigned) || errors.Is(err, entities.ErrReviewNotStale) ||
+		errors.Is(err, entities.ErrPairingViolation) || errors.Is(err, entities.ErrSeniorRequired)
 }
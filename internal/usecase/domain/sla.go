@@ -0,0 +1,68 @@
+// Package domain contains application Usecases orchestrating domain logic by review SLA.
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// ReassignStaleReviews hands over pending reviews on open PRs that have waited longer than sla,
+// following the rules of ReassignPullRequest. Reviews without a replacement stay assigned.
+// A failed review does not stop the sweep; it returns the number of reassigned reviews and the joined failures.
+func (u *Usecase) ReassignStaleReviews(ctx context.Context, sla time.Duration) (int, error) {
+	if sla <= 0 {
+		u.log.Errorw("failed to reassign stale reviews: invalid sla", "sla", sla)
+		return 0, fmt.Errorf("%w: sla must be positive", entities.ErrInvalidArgument)
+	}
+
+	listCtx, cancel := withTimeout(ctx, u.timeout)
+	reviews, err := u.repo.ListStaleReviews(listCtx, sla)
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	var errs []error
+	for _, review := range reviews {
+		reassignCtx, cancel := withTimeout(ctx, u.timeout)
+		_, repl, err := u.repo.ReassignStaleReview(reassignCtx, review.PRID, review.ReviewerID, sla, u.selector)
+		cancel()
+		switch {
+		case err == nil:
+			reassigned++
+			u.log.Infow("stale review reassigned", "pr_id", review.PRID, "old", review.ReviewerID, "new", repl, "waiting_since", review.WaitingSince)
+		case keepsReviewer(err):
+			u.log.Warnw("stale review left as is", "pr_id", review.PRID, "reviewer", review.ReviewerID, "reason", err)
+		default:
+			u.log.Errorw("failed to reassign stale review", "pr_id", review.PRID, "reviewer", review.ReviewerID, "error", err)
+			errs = append(errs, err)
+		}
+	}
+	return reassigned, errors.Join(errs...)
+}
+
+// RunSLAWatcher periodically reassigns reviews waiting longer than sla until ctx is done.
+func (u *Usecase) RunSLAWatcher(ctx context.Context, interval, sla time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := u.ReassignStaleReviews(ctx, sla)
+			if err != nil {
+				u.log.Errorw("review SLA watcher run failed", "error", err)
+			}
+			if n > 0 {
+				u.log.Infow("stale reviews reassigned", "reassigned", n)
+			}
+		}
+	}
+}
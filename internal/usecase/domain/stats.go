@@ -4,6 +4,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"assigning-reviewers-for-pr/internal/entities"
 )
@@ -23,6 +24,10 @@ func (u *Usecase) SummaryStats(ctx context.Context, filter entities.StatsFilter)
 	if filter.Limit <= 0 {
 		filter.Limit = 10
 	}
+	if filter.Status != nil && !filter.Status.IsValid() {
+		u.log.Errorw("failed to get summary stats: unknown status", "status", *filter.Status)
+		return entities.StatsSummary{}, fmt.Errorf("%w: unknown status %q", entities.ErrInvalidArgument, *filter.Status)
+	}
 	return u.repo.StatsSummary(ctx, filter)
 }
 
@@ -38,7 +43,17 @@ func (u *Usecase) ReviewerStats(ctx context.Context, userID string, limit int) (
 	if limit <= 0 {
 		limit = 10
 	}
-	return u.repo.ReviewerStats(ctx, userID, limit)
+	res, err := u.repo.ReviewerStats(ctx, userID, limit)
+	if err != nil {
+		return res, err
+	}
+	now := time.Now()
+	local := res.Schedule.LocalTime(now)
+	res.LocalTime = &local
+	res.InWorkingHours = res.Schedule.IsWorking(now)
+	res.CapacityUsage = res.Capacity.Usage(res.OpenPRCnt)
+	res.AtCapacity = res.Capacity.Reached(res.OpenPRCnt)
+	return res, nil
 }
 
 // PRStats returns stats for a specific pull request.
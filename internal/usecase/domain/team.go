@@ -8,7 +8,7 @@ import (
 	"assigning-reviewers-for-pr/internal/entities"
 )
 
-// CreateTeam creates a team with members.
+// CreateTeam creates a team with members, under its parent team when one is set.
 func (u *Usecase) CreateTeam(ctx context.Context, team entities.Team) (*entities.Team, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
@@ -17,11 +17,21 @@ func (u *Usecase) CreateTeam(ctx context.Context, team entities.Team) (*entities
 		u.log.Errorw("failed to create team: missing team_name")
 		return nil, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
 	}
+	if team.Parent == team.Name {
+		u.log.Errorw("failed to create team: team is its own parent", "team", team.Name)
+		return nil, fmt.Errorf("%w: team cannot be its own parent", entities.ErrInvalidArgument)
+	}
+	for _, m := range team.Members {
+		if m.Role != "" && !m.Role.IsValid() {
+			u.log.Errorw("failed to create team: unknown role", "team", team.Name, "user_id", m.ID, "role", m.Role)
+			return nil, fmt.Errorf("%w: unknown role %q for user %s", entities.ErrInvalidArgument, m.Role, m.ID)
+		}
+	}
 	return u.repo.CreateTeam(ctx, team)
 }
 
-// Team returns team by name.
-func (u *Usecase) Team(ctx context.Context, name string) (*entities.Team, error) {
+// Team returns team by name, with the teams below it on all levels when withDescendants is set.
+func (u *Usecase) Team(ctx context.Context, name string, withDescendants bool) (*entities.Team, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
 
@@ -29,11 +39,78 @@ func (u *Usecase) Team(ctx context.Context, name string) (*entities.Team, error)
 		u.log.Errorw("failed to get team: missing team_name")
 		return nil, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
 	}
-	return u.repo.GetTeam(ctx, name)
+	team, err := u.repo.GetTeam(ctx, name)
+	if err != nil || !withDescendants {
+		return team, err
+	}
+	if team.Descendants, err = u.repo.ListTeamDescendants(ctx, name); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// AddTeamMember adds a user to a team; moving a member of another team requires force.
+func (u *Usecase) AddTeamMember(ctx context.Context, teamName string, member entities.User, force bool) (entities.MembershipResult, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if teamName == "" || member.ID == "" {
+		u.log.Errorw("failed to add team member: missing team_name or user_id", "team", teamName, "user_id", member.ID)
+		return entities.MembershipResult{}, fmt.Errorf("%w: team_name and user_id are required", entities.ErrInvalidArgument)
+	}
+	if member.Role != "" && !member.Role.IsValid() {
+		u.log.Errorw("failed to add team member: unknown role", "team", teamName, "user_id", member.ID, "role", member.Role)
+		return entities.MembershipResult{}, fmt.Errorf("%w: unknown role %q for user %s", entities.ErrInvalidArgument, member.Role, member.ID)
+	}
+	return u.repo.AddTeamMember(ctx, teamName, member, force, u.selector)
+}
+
+// RemoveTeamMember takes a user out of a team and hands their open reviews over to the rest of it.
+func (u *Usecase) RemoveTeamMember(ctx context.Context, teamName, userID string) (entities.MembershipResult, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if teamName == "" || userID == "" {
+		u.log.Errorw("failed to remove team member: missing team_name or user_id", "team", teamName, "user_id", userID)
+		return entities.MembershipResult{}, fmt.Errorf("%w: team_name and user_id are required", entities.ErrInvalidArgument)
+	}
+	return u.repo.RemoveTeamMember(ctx, teamName, userID, u.selector)
+}
+
+// RenameTeam changes the name of a team.
+func (u *Usecase) RenameTeam(ctx context.Context, name, newName string) (*entities.Team, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if name == "" || newName == "" {
+		u.log.Errorw("failed to rename team: missing team_name or new_team_name", "team", name, "new_name", newName)
+		return nil, fmt.Errorf("%w: team_name and new_team_name are required", entities.ErrInvalidArgument)
+	}
+	if name == newName {
+		return u.repo.GetTeam(ctx, name)
+	}
+	return u.repo.RenameTeam(ctx, name, newName)
+}
+
+// SetTeamParent moves a team under parent, or to the top level when parent is empty.
+func (u *Usecase) SetTeamParent(ctx context.Context, name, parent string) (*entities.Team, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if name == "" {
+		u.log.Errorw("failed to set team parent: missing team_name")
+		return nil, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
+	}
+	if parent == name {
+		u.log.Errorw("failed to set team parent: team is its own parent", "team", name)
+		return nil, fmt.Errorf("%w: team cannot be its own parent", entities.ErrInvalidArgument)
+	}
+	return u.repo.SetTeamParent(ctx, name, parent)
 }
 
-// DeactivateTeam deactivates users of a team and cleans reviewer assignments.
-func (u *Usecase) DeactivateTeam(ctx context.Context, teamName string) (entities.DeactivateResult, error) {
+// DeactivateTeam deactivates users of a team, and of the teams below it when withDescendants is set,
+// and cleans reviewer assignments.
+func (u *Usecase) DeactivateTeam(ctx context.Context, teamName string, withDescendants bool) (entities.DeactivateResult, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
 
@@ -41,5 +118,5 @@ func (u *Usecase) DeactivateTeam(ctx context.Context, teamName string) (entities
 		u.log.Errorw("failed to deactivate team: missing team_name")
 		return entities.DeactivateResult{}, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
 	}
-	return u.repo.DeactivateTeam(ctx, teamName)
+	return u.repo.DeactivateTeam(ctx, teamName, withDescendants, u.selector)
 }
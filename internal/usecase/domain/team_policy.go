@@ -0,0 +1,61 @@
+// Package domain contains application Usecases orchestrating domain logic by team policy.
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// TeamPolicy returns the reviewer assignment policy of a team.
+func (u *Usecase) TeamPolicy(ctx context.Context, teamName string) (*entities.TeamPolicy, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if teamName == "" {
+		u.log.Errorw("failed to get team policy: missing team_name")
+		return nil, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.GetTeamPolicy(ctx, teamName)
+}
+
+// SetTeamPolicy validates and stores the reviewer assignment policy of a team.
+func (u *Usecase) SetTeamPolicy(ctx context.Context, policy entities.TeamPolicy) (*entities.TeamPolicy, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if policy.TeamName == "" {
+		u.log.Errorw("failed to set team policy: missing team_name")
+		return nil, fmt.Errorf("%w: team_name is required", entities.ErrInvalidArgument)
+	}
+	if policy.ReviewerCount < 1 || policy.ReviewerCount > entities.MaxReviewerCount {
+		u.log.Errorw("failed to set team policy: reviewer_count out of range", "reviewer_count", policy.ReviewerCount)
+		return nil, fmt.Errorf("%w: reviewer_count must be between 1 and %d", entities.ErrInvalidArgument, entities.MaxReviewerCount)
+	}
+	if policy.SelectionMode != "" && !policy.SelectionMode.IsValid() {
+		u.log.Errorw("failed to set team policy: unknown selection_mode", "selection_mode", policy.SelectionMode)
+		return nil, fmt.Errorf("%w: unknown selection_mode %q", entities.ErrInvalidArgument, policy.SelectionMode)
+	}
+	if policy.Merge.MinReviewers < 0 || policy.Merge.MinReviewers > entities.MaxReviewerCount {
+		u.log.Errorw("failed to set team policy: merge min_reviewers out of range", "min_reviewers", policy.Merge.MinReviewers)
+		return nil, fmt.Errorf("%w: merge_policy.min_reviewers must be between 0 and %d", entities.ErrInvalidArgument, entities.MaxReviewerCount)
+	}
+	if policy.Merge.MinAge < 0 {
+		u.log.Errorw("failed to set team policy: negative merge min_age", "min_age", policy.Merge.MinAge)
+		return nil, fmt.Errorf("%w: merge_policy.min_age_seconds must not be negative", entities.ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(policy.FallbackTeams))
+	for _, name := range policy.FallbackTeams {
+		if name == "" || name == policy.TeamName {
+			u.log.Errorw("failed to set team policy: invalid fallback team", "team", policy.TeamName, "fallback", name)
+			return nil, fmt.Errorf("%w: fallback team must name another team", entities.ErrInvalidArgument)
+		}
+		if _, ok := seen[name]; ok {
+			u.log.Errorw("failed to set team policy: duplicate fallback team", "team", policy.TeamName, "fallback", name)
+			return nil, fmt.Errorf("%w: duplicate fallback team %q", entities.ErrInvalidArgument, name)
+		}
+		seen[name] = struct{}{}
+	}
+	return u.repo.SetTeamPolicy(ctx, policy)
+}
@@ -4,6 +4,8 @@ package domain
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"assigning-reviewers-for-pr/internal/entities"
 )
@@ -21,8 +23,77 @@ func (u *Usecase) SetActiveUser(ctx context.Context, userID string, isActive boo
 	return u.repo.SetUserActive(ctx, userID, isActive)
 }
 
-// GetReviewList returns PRs where the user is assigned as reviewer.
-func (u *Usecase) GetReviewList(ctx context.Context, userID string) ([]entities.PullRequestShort, error) {
+// SetUserSkills replaces user skills with the normalized set.
+func (u *Usecase) SetUserSkills(ctx context.Context, userID string, skills []string) (*entities.User, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if userID == "" {
+		u.log.Errorw("failed to set user skills: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	normalized, err := normalizeTags(skills)
+	if err != nil {
+		u.log.Errorw("failed to set user skills", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return u.repo.SetUserSkills(ctx, userID, normalized)
+}
+
+// SetUserSchedule validates and stores the user's time zone and working hours.
+func (u *Usecase) SetUserSchedule(ctx context.Context, userID string, schedule entities.WorkSchedule) (*entities.User, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if userID == "" {
+		u.log.Errorw("failed to set user schedule: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	if err := schedule.Validate(); err != nil {
+		u.log.Errorw("failed to set user schedule", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return u.repo.SetUserSchedule(ctx, userID, schedule)
+}
+
+// SetUserCapacity validates and stores the user's review cap and weight.
+func (u *Usecase) SetUserCapacity(ctx context.Context, userID string, capacity entities.ReviewCapacity) (*entities.User, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if userID == "" {
+		u.log.Errorw("failed to set user capacity: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	if err := capacity.Validate(); err != nil {
+		u.log.Errorw("failed to set user capacity", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return u.repo.SetUserCapacity(ctx, userID, capacity)
+}
+
+// SetUserRole stores the user's seniority role.
+func (u *Usecase) SetUserRole(ctx context.Context, userID string, role entities.Role) (*entities.User, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if userID == "" {
+		u.log.Errorw("failed to set user role: missing userID")
+		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
+	}
+	if !role.IsValid() {
+		u.log.Errorw("failed to set user role: unknown role", "user_id", userID, "role", role)
+		return nil, fmt.Errorf("%w: unknown role %q", entities.ErrInvalidArgument, role)
+	}
+
+	return u.repo.SetUserRole(ctx, userID, role)
+}
+
+// GetReviewList returns PRs where the user is assigned as reviewer, optionally filtered by label and review state.
+func (u *Usecase) GetReviewList(ctx context.Context, userID, label string, state entities.ReviewState) ([]entities.PullRequestShort, error) {
 	ctx, cancel := withTimeout(ctx, u.timeout)
 	defer cancel()
 
@@ -30,6 +101,29 @@ func (u *Usecase) GetReviewList(ctx context.Context, userID string) ([]entities.
 		u.log.Errorw("failed to get user reviews: missing userID")
 		return nil, fmt.Errorf("%w: userID is required", entities.ErrInvalidArgument)
 	}
+	if state != "" && !state.IsValid() {
+		u.log.Errorw("failed to get user reviews: unknown review state", "user_id", userID, "state", state)
+		return nil, fmt.Errorf("%w: unknown review state %q", entities.ErrInvalidArgument, state)
+	}
 
-	return u.repo.GetUserReviews(ctx, userID)
+	return u.repo.GetUserReviews(ctx, userID, strings.ToLower(strings.TrimSpace(label)), state)
+}
+
+// normalizeTags lowercases, trims, dedupes and sorts skill or label tags.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || len(t) > entities.MaxTagLength {
+			return nil, fmt.Errorf("%w: tags must be 1..%d characters long", entities.ErrInvalidArgument, entities.MaxTagLength)
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	sort.Strings(res)
+	return res, nil
 }
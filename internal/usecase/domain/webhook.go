@@ -0,0 +1,90 @@
+// Package domain contains application Usecases orchestrating domain logic by webhooks.
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+// AddWebhook validates and stores a webhook subscription; duplicate events are dropped.
+func (u *Usecase) AddWebhook(ctx context.Context, sub entities.WebhookSubscription) (*entities.WebhookSubscription, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	sub.URL = strings.TrimSpace(sub.URL)
+	target, err := url.Parse(sub.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		u.log.Errorw("failed to add webhook: invalid url", "url", sub.URL)
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", entities.ErrInvalidArgument)
+	}
+	if sub.Secret == "" {
+		u.log.Errorw("failed to add webhook: missing secret", "url", sub.URL)
+		return nil, fmt.Errorf("%w: secret is required", entities.ErrInvalidArgument)
+	}
+	if len(sub.Events) == 0 {
+		u.log.Errorw("failed to add webhook: no events", "url", sub.URL)
+		return nil, fmt.Errorf("%w: at least one event is required", entities.ErrInvalidArgument)
+	}
+	seen := make(map[entities.WebhookEvent]struct{}, len(sub.Events))
+	events := make([]entities.WebhookEvent, 0, len(sub.Events))
+	for _, e := range sub.Events {
+		if !e.IsValid() {
+			u.log.Errorw("failed to add webhook: unknown event", "event", e)
+			return nil, fmt.Errorf("%w: unknown event %q", entities.ErrInvalidArgument, e)
+		}
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		events = append(events, e)
+	}
+	sub.Events = events
+	return u.repo.AddWebhookSubscription(ctx, sub)
+}
+
+// Webhooks returns all webhook subscriptions.
+func (u *Usecase) Webhooks(ctx context.Context) ([]entities.WebhookSubscription, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	return u.repo.ListWebhookSubscriptions(ctx)
+}
+
+// DeleteWebhook removes a webhook subscription.
+func (u *Usecase) DeleteWebhook(ctx context.Context, id int64) (*entities.WebhookSubscription, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if id <= 0 {
+		u.log.Errorw("failed to delete webhook: invalid id", "id", id)
+		return nil, fmt.Errorf("%w: id is required", entities.ErrInvalidArgument)
+	}
+	return u.repo.DeleteWebhookSubscription(ctx, id)
+}
+
+// WebhookDeliveries returns the delivery log, newest first; the limit defaults to 50 and is capped at 500.
+func (u *Usecase) WebhookDeliveries(ctx context.Context, filter entities.DeliveryFilter) ([]entities.WebhookDelivery, error) {
+	ctx, cancel := withTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		u.log.Errorw("failed to list webhook deliveries: unknown status", "status", *filter.Status)
+		return nil, fmt.Errorf("%w: unknown status %q", entities.ErrInvalidArgument, *filter.Status)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDeliveryLimit
+	}
+	if filter.Limit > maxDeliveryLimit {
+		filter.Limit = maxDeliveryLimit
+	}
+	return u.repo.ListWebhookDeliveries(ctx, filter)
+}
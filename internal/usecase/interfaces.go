@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"assigning-reviewers-for-pr/internal/entities"
 )
@@ -9,21 +10,92 @@ import (
 // UserUsecaseInterface abstracts user-related operations for delivery layer.
 type UserUsecaseInterface interface {
 	SetActiveUser(ctx context.Context, userID string, isActive bool) (*entities.User, error)
-	GetReviewList(ctx context.Context, userID string) ([]entities.PullRequestShort, error)
+	SetUserSkills(ctx context.Context, userID string, skills []string) (*entities.User, error)
+	SetUserSchedule(ctx context.Context, userID string, schedule entities.WorkSchedule) (*entities.User, error)
+	SetUserCapacity(ctx context.Context, userID string, capacity entities.ReviewCapacity) (*entities.User, error)
+	SetUserRole(ctx context.Context, userID string, role entities.Role) (*entities.User, error)
+	GetReviewList(ctx context.Context, userID, label string, state entities.ReviewState) ([]entities.PullRequestShort, error)
+	Users(ctx context.Context, filter entities.UserFilter, cursor string) (entities.Page[entities.User], error)
+}
+
+// AvailabilityUsecaseInterface abstracts out-of-office periods management.
+type AvailabilityUsecaseInterface interface {
+	AddUnavailability(ctx context.Context, period entities.Unavailability) (*entities.Unavailability, error)
+	Unavailability(ctx context.Context, userID string) ([]entities.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) (*entities.Unavailability, error)
+	ReassignUnavailableReviews(ctx context.Context) (int, error)
+	RunUnavailabilityWatcher(ctx context.Context, interval time.Duration)
 }
 
 // TeamUsecaseInterface abstracts team-related operations.
 type TeamUsecaseInterface interface {
 	CreateTeam(ctx context.Context, team entities.Team) (*entities.Team, error)
-	Team(ctx context.Context, name string) (*entities.Team, error)
-	DeactivateTeam(ctx context.Context, teamName string) (entities.DeactivateResult, error)
+	Team(ctx context.Context, name string, withDescendants bool) (*entities.Team, error)
+	Teams(ctx context.Context, filter entities.TeamFilter, cursor string) (entities.Page[entities.TeamSummary], error)
+	AddTeamMember(ctx context.Context, teamName string, member entities.User, force bool) (entities.MembershipResult, error)
+	RemoveTeamMember(ctx context.Context, teamName, userID string) (entities.MembershipResult, error)
+	RenameTeam(ctx context.Context, name, newName string) (*entities.Team, error)
+	SetTeamParent(ctx context.Context, name, parent string) (*entities.Team, error)
+	DeactivateTeam(ctx context.Context, teamName string, withDescendants bool) (entities.DeactivateResult, error)
+	TeamPolicy(ctx context.Context, teamName string) (*entities.TeamPolicy, error)
+	SetTeamPolicy(ctx context.Context, policy entities.TeamPolicy) (*entities.TeamPolicy, error)
 }
 
 // PullRequestUsecaseInterface abstracts PR-related operations.
 type PullRequestUsecaseInterface interface {
 	CreatePullRequest(ctx context.Context, pr entities.PullRequest) (*entities.PullRequest, error)
+	PreviewAssignment(ctx context.Context, pr entities.PullRequest) (*entities.AssignmentPreview, error)
+	PullRequest(ctx context.Context, prID string) (*entities.PullRequest, error)
+	PullRequests(ctx context.Context, filter entities.PullRequestFilter, cursor string) (entities.Page[entities.PullRequest], error)
 	MergePullRequest(ctx context.Context, prID string) (*entities.PullRequest, error)
-	ReassignPullRequest(ctx context.Context, prID, oldUserID string) (*entities.PullRequest, string, error)
+	ReadyPullRequest(ctx context.Context, prID string, changedFiles []string) (*entities.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string) (*entities.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string) (*entities.PullRequest, error)
+	ReassignPullRequest(ctx context.Context, prID, oldUserID, newUserID string) (*entities.PullRequest, string, error)
+	AddReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, userID string) (*entities.PullRequest, error)
+	SubmitReview(ctx context.Context, prID, userID string, state entities.ReviewState) (*entities.PullRequest, string, error)
+	ReassignStaleReviews(ctx context.Context, sla time.Duration) (int, error)
+	RunSLAWatcher(ctx context.Context, interval, sla time.Duration)
+}
+
+// CodeHostUsecaseInterface abstracts PR changes pushed by code host webhooks and the reviewer push state.
+type CodeHostUsecaseInterface interface {
+	ApplyCodeHostEvent(ctx context.Context, event entities.CodeHostEvent) (*entities.PullRequest, error)
+	CodeHostSyncs(ctx context.Context, filter entities.CodeHostSyncFilter) ([]entities.CodeHostSync, error)
+	PruneCodeHostSyncs(ctx context.Context, retention time.Duration) (int64, error)
+	RunCodeHostSyncPruner(ctx context.Context, interval, retention time.Duration)
+}
+
+// OwnershipUsecaseInterface abstracts code ownership rules management.
+type OwnershipUsecaseInterface interface {
+	OwnershipRules(ctx context.Context) ([]entities.OwnershipRule, error)
+	SetOwnershipRules(ctx context.Context, rules []entities.OwnershipRule) ([]entities.OwnershipRule, error)
+	ImportCodeowners(ctx context.Context, content string) ([]entities.OwnershipRule, error)
+}
+
+// PairingUsecaseInterface abstracts author/reviewer pairing rules management.
+type PairingUsecaseInterface interface {
+	AddPairingRule(ctx context.Context, rule entities.PairingRule) (*entities.PairingRule, error)
+	PairingRules(ctx context.Context, userID string) ([]entities.PairingRule, error)
+	DeletePairingRule(ctx context.Context, id int64) (*entities.PairingRule, error)
+}
+
+// WebhookUsecaseInterface abstracts webhook subscriptions management and the delivery log.
+type WebhookUsecaseInterface interface {
+	AddWebhook(ctx context.Context, sub entities.WebhookSubscription) (*entities.WebhookSubscription, error)
+	Webhooks(ctx context.Context) ([]entities.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int64) (*entities.WebhookSubscription, error)
+	WebhookDeliveries(ctx context.Context, filter entities.DeliveryFilter) ([]entities.WebhookDelivery, error)
+}
+
+// EventUsecaseInterface abstracts the live domain event stream.
+type EventUsecaseInterface interface {
+	SubscribeEvents(ctx context.Context, filter entities.EventFilter, lastEventID int64) (entities.EventSubscription, error)
+	RunEventStream(ctx context.Context, interval, gapTimeout time.Duration)
+	DisableEventStream()
+	PruneEvents(ctx context.Context, retention time.Duration) (int64, error)
+	RunEventPruner(ctx context.Context, interval, retention time.Duration)
 }
 
 // StatsUsecaseInterface abstracts statistics operations.
@@ -0,0 +1,55 @@
+// Package outbox holds the leasing, retry schedule and polling loop shared by the workers that
+// drain transactional outboxes: webhook deliveries and code host reviewer syncs.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// leaseMargin keeps a batch leased a little past the time its requests may take.
+const leaseMargin = 30 * time.Second
+
+// Lease returns how long a claimed batch of batchSize items stays leased when each item makes
+// up to requests sequential calls of at most requestTimeout, so no item is claimed twice meanwhile.
+func Lease(batchSize, requests int, requestTimeout time.Duration) time.Duration {
+	return time.Duration(batchSize*requests)*requestTimeout + leaseMargin
+}
+
+// Backoff returns the delay after the given number of failed attempts: base after the first one,
+// doubling with every further one up to limit.
+func Backoff(failed int, base, limit time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < failed; i++ {
+		delay *= 2
+		if delay >= limit {
+			return limit
+		}
+	}
+	return min(delay, limit)
+}
+
+// Run calls drain every interval until ctx is done. Errors are logged unless ctx has ended,
+// and batches that processed anything are logged with their count; name labels both.
+func Run(ctx context.Context, log *zap.SugaredLogger, name string, interval time.Duration, drain func(context.Context) (int, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := drain(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Errorw("outbox run failed", "outbox", name, "error", err)
+				continue
+			}
+			if n > 0 {
+				log.Infow("outbox batch processed", "outbox", name, "processed", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBackoffIsCapped(t *testing.T) {
+	require.Equal(t, time.Second, Backoff(1, time.Second, 3*time.Second))
+	require.Equal(t, 2*time.Second, Backoff(2, time.Second, 3*time.Second))
+	require.Equal(t, 3*time.Second, Backoff(3, time.Second, 3*time.Second))
+	require.Equal(t, 3*time.Second, Backoff(40, time.Second, 3*time.Second))
+}
+
+func TestLeaseCoversSequentialRequests(t *testing.T) {
+	require.Equal(t, 20*time.Second+leaseMargin, Lease(10, 2, time.Second))
+}
+
+func TestRunDrainsUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Run(ctx, zap.NewNop().Sugar(), "test", time.Millisecond, func(context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				return 0, errors.New("boom")
+			}
+			return 1, nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after cancel")
+	}
+}
@@ -0,0 +1,173 @@
+// Package ownership implements CODEOWNERS-style path matching and parsing.
+package ownership
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// Matcher resolves code owners of changed paths against an ordered rule set.
+type Matcher struct {
+	rules    []entities.OwnershipRule
+	patterns []*regexp.Regexp
+}
+
+// NewMatcher compiles the rules; it fails on patterns CODEOWNERS does not support.
+func NewMatcher(rules []entities.OwnershipRule) (*Matcher, error) {
+	patterns := make([]*regexp.Regexp, 0, len(rules))
+	for _, r := range rules {
+		re, err := compilePattern(r.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, re)
+	}
+	return &Matcher{rules: rules, patterns: patterns}, nil
+}
+
+// Owners returns the deduplicated owners of all paths, taking the last matching rule for each path.
+func (m *Matcher) Owners(paths []string) []entities.Owner {
+	seen := make(map[entities.Owner]struct{})
+	res := make([]entities.Owner, 0)
+	for _, path := range paths {
+		path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+		if path == "" {
+			continue
+		}
+		for i := len(m.rules) - 1; i >= 0; i-- {
+			if !m.patterns[i].MatchString(path) {
+				continue
+			}
+			for _, o := range m.rules[i].Owners {
+				if _, ok := seen[o]; ok {
+					continue
+				}
+				seen[o] = struct{}{}
+				res = append(res, o)
+			}
+			break
+		}
+	}
+	return res
+}
+
+// ValidateRules checks patterns and owner references of the rules.
+func ValidateRules(rules []entities.OwnershipRule) error {
+	for _, r := range rules {
+		if _, err := compilePattern(r.Pattern); err != nil {
+			return err
+		}
+		for _, o := range r.Owners {
+			if o.ID == "" || (o.Kind != entities.OwnerUser && o.Kind != entities.OwnerTeam) {
+				return fmt.Errorf("%w: invalid owner of pattern %q", entities.ErrInvalidArgument, r.Pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseCodeowners parses GitHub CODEOWNERS content.
+// Users are referenced as @user_id and teams as @org/team_name; the org part is ignored.
+func ParseCodeowners(content string) ([]entities.OwnershipRule, error) {
+	rules := make([]entities.OwnershipRule, 0)
+	sc := bufio.NewScanner(strings.NewReader(content))
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := stripComment(sc.Text())
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rule := entities.OwnershipRule{Pattern: strings.ReplaceAll(fields[0], `\#`, "#"), Owners: make([]entities.Owner, 0, len(fields)-1)}
+		if _, err := compilePattern(rule.Pattern); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		for _, ref := range fields[1:] {
+			owner, err := parseOwner(ref)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+		rules = append(rules, rule)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("%w: read codeowners: %v", entities.ErrInvalidArgument, err)
+	}
+	return rules, nil
+}
+
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] != '\\') {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func parseOwner(ref string) (entities.Owner, error) {
+	if !strings.HasPrefix(ref, "@") || len(ref) == 1 {
+		return entities.Owner{}, fmt.Errorf("%w: unsupported owner %q, expected @user or @org/team", entities.ErrInvalidArgument, ref)
+	}
+	name := ref[1:]
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		if idx == len(name)-1 {
+			return entities.Owner{}, fmt.Errorf("%w: empty team name in %q", entities.ErrInvalidArgument, ref)
+		}
+		return entities.Owner{Kind: entities.OwnerTeam, ID: name[idx+1:]}, nil
+	}
+	return entities.Owner{Kind: entities.OwnerUser, ID: name}, nil
+}
+
+// compilePattern turns a CODEOWNERS pattern into a regexp over slash-separated repository paths.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: empty pattern", entities.ErrInvalidArgument)
+	}
+	if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("%w: pattern %q uses syntax CODEOWNERS does not support", entities.ErrInvalidArgument, pattern)
+	}
+
+	body := strings.TrimSuffix(pattern, "/")
+	anchored := strings.HasPrefix(body, "/") || strings.Contains(body, "/")
+	body = strings.TrimPrefix(body, "/")
+	if body == "" {
+		return regexp.MustCompile(`^.*$`), nil
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(body); i++ {
+		switch {
+		case strings.HasPrefix(body[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(body[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case body[i] == '*':
+			b.WriteString("[^/]*")
+		case body[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(body[i : i+1]))
+		}
+	}
+	// "dir/*" only matches direct children; everything else also covers nested paths.
+	if strings.HasSuffix(pattern, "/*") {
+		b.WriteString("$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,76 @@
+package ownership
+
+import (
+	"testing"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"*", "any/file.txt", true},
+		{"*.go", "internal/app/main.go", true},
+		{"*.go", "internal/app/main.py", false},
+		{"/build/logs/", "build/logs/a/b.log", true},
+		{"/build/logs/", "src/build/logs/a.log", false},
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		{"apps/", "src/apps/web/index.ts", true},
+		{"**/migrations", "db/nested/migrations/001.sql", true},
+		{"/db/**/*.sql", "db/migrations/001.sql", true},
+		{"/db/**/*.sql", "db/001.sql", true},
+		{"README.md", "pkg/README.md", true},
+		{"/README.md", "pkg/README.md", false},
+		{"internal/repository", "internal/repository/postgres/team.go", true},
+		{"internal/repository", "x/internal/repository/team.go", false},
+	}
+
+	for _, tt := range tests {
+		re, err := compilePattern(tt.pattern)
+		require.NoError(t, err, tt.pattern)
+		require.Equal(t, tt.match, re.MatchString(tt.path), "%s vs %s", tt.pattern, tt.path)
+	}
+
+	_, err := compilePattern("!vendor/")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+	_, err = compilePattern("[abc].go")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestParseCodeowners(t *testing.T) {
+	content := `
+# default owners
+*       @u1
+
+/db/    @acme/dba @u2   # inline comment
+*.md
+`
+	rules, err := ParseCodeowners(content)
+	require.NoError(t, err)
+	require.Equal(t, []entities.OwnershipRule{
+		{Pattern: "*", Owners: []entities.Owner{{Kind: entities.OwnerUser, ID: "u1"}}},
+		{Pattern: "/db/", Owners: []entities.Owner{{Kind: entities.OwnerTeam, ID: "dba"}, {Kind: entities.OwnerUser, ID: "u2"}}},
+		{Pattern: "*.md", Owners: []entities.Owner{}},
+	}, rules)
+
+	_, err = ParseCodeowners("*.go dev@example.com")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestMatcherLastRuleWins(t *testing.T) {
+	rules, err := ParseCodeowners("* @u1\n/db/ @acme/dba\n*.md\n")
+	require.NoError(t, err)
+	m, err := NewMatcher(rules)
+	require.NoError(t, err)
+
+	require.Equal(t, []entities.Owner{
+		{Kind: entities.OwnerTeam, ID: "dba"},
+		{Kind: entities.OwnerUser, ID: "u1"},
+	}, m.Owners([]string{"db/migrations/1.sql", "cmd/main.go", "db/schema.sql"}))
+	require.Empty(t, m.Owners([]string{"docs/README.md"}))
+}
@@ -0,0 +1,52 @@
+package selector
+
+import (
+	"sort"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// LeastLoaded prefers candidates with the fewest assignments per unit of review weight, breaking ties randomly.
+type LeastLoaded struct{}
+
+// NewLeastLoaded constructs a least-loaded selector.
+func NewLeastLoaded() *LeastLoaded {
+	return &LeastLoaded{}
+}
+
+// Select returns up to req.Count candidates ordered by ascending assignment count.
+func (LeastLoaded) Select(req entities.SelectionRequest) []string {
+	return withPreferred(req, func(pool []entities.Candidate, n int) []string {
+		return pickLowest(pool, n, func(c entities.Candidate) int64 { return c.AssignCnt })
+	})
+}
+
+// LeastOpen prefers candidates with the fewest reviews on OPEN pull requests per unit of review weight,
+// breaking ties randomly.
+type LeastOpen struct{}
+
+// NewLeastOpen constructs a least-open-reviews selector.
+func NewLeastOpen() *LeastOpen {
+	return &LeastOpen{}
+}
+
+// Select returns up to req.Count candidates ordered by ascending open review count.
+func (LeastOpen) Select(req entities.SelectionRequest) []string {
+	return withPreferred(req, func(pool []entities.Candidate, n int) []string {
+		return pickLowest(pool, n, func(c entities.Candidate) int64 { return c.OpenReviews })
+	})
+}
+
+// pickLowest picks the n candidates with the lowest load divided by weight,
+// so a candidate with weight 2 is expected to carry twice the load of one with weight 1.
+func pickLowest(src []entities.Candidate, n int, load func(entities.Candidate) int64) []string {
+	pool := shuffle(src)
+	share := func(c entities.Candidate) float64 { return float64(load(c)) / c.ReviewWeight() }
+	sort.SliceStable(pool, func(i, j int) bool {
+		return share(pool[i]) < share(pool[j])
+	})
+	if n < len(pool) {
+		pool = pool[:n]
+	}
+	return candidateIDs(pool)
+}
@@ -0,0 +1,18 @@
+package selector
+
+import "assigning-reviewers-for-pr/internal/entities"
+
+// Random picks reviewers at random in proportion to their review weight.
+type Random struct{}
+
+// NewRandom constructs a weighted random selector.
+func NewRandom() *Random {
+	return &Random{}
+}
+
+// Select returns up to req.Count random candidates.
+func (Random) Select(req entities.SelectionRequest) []string {
+	return withPreferred(req, func(pool []entities.Candidate, n int) []string {
+		return pickWeighted(pool, n)
+	})
+}
@@ -0,0 +1,79 @@
+package selector
+
+import (
+	"math"
+	"sync"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// RoundRobin rotates assignments in proportion to review weight: every pick advances the candidate's
+// pass by 1/weight and the lowest pass goes next, the least recently picked candidate first on ties.
+// A candidate that missed rounds rejoins one stride behind the rotation instead of catching up on
+// everything it missed. With equal weights this is plain least-recently-picked rotation.
+// State is kept in memory, so rotation restarts after a process restart.
+type RoundRobin struct {
+	mu    sync.Mutex
+	seq   uint64
+	last  map[string]uint64
+	pass  map[string]float64
+	clock float64
+}
+
+// NewRoundRobin constructs a round-robin selector.
+func NewRoundRobin() *RoundRobin {
+	return &RoundRobin{last: make(map[string]uint64), pass: make(map[string]float64)}
+}
+
+// Select returns up to req.Count candidates whose turn comes first.
+func (r *RoundRobin) Select(req entities.SelectionRequest) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return withPreferred(req, func(pool []entities.Candidate, n int) []string {
+		return r.pick(pool, n, !req.DryRun)
+	})
+}
+
+func (r *RoundRobin) pick(src []entities.Candidate, n int, record bool) []string {
+	pool := append([]entities.Candidate(nil), src...)
+	seq, clock := r.seq, r.clock
+	last := make(map[string]uint64, len(pool))
+	pass := make(map[string]float64, len(pool))
+	for _, c := range pool {
+		last[c.UserID] = r.last[c.UserID]
+		pass[c.UserID] = r.pass[c.UserID]
+	}
+	start := func(c entities.Candidate) float64 {
+		return math.Max(pass[c.UserID], clock-1/c.ReviewWeight())
+	}
+
+	res := make([]string, 0, n)
+	for len(res) < n && len(pool) > 0 {
+		best := 0
+		for i := 1; i < len(pool); i++ {
+			si, sb := start(pool[i]), start(pool[best])
+			if si < sb || si == sb && (last[pool[i].UserID] < last[pool[best].UserID] ||
+				last[pool[i].UserID] == last[pool[best].UserID] && pool[i].UserID < pool[best].UserID) {
+				best = i
+			}
+		}
+		c := pool[best]
+		s := start(c)
+		pass[c.UserID] = s + 1/c.ReviewWeight()
+		clock = math.Max(clock, s)
+		seq++
+		last[c.UserID] = seq
+		res = append(res, c.UserID)
+		pool = append(pool[:best], pool[best+1:]...)
+	}
+
+	if record {
+		r.seq, r.clock = seq, clock
+		for _, id := range res {
+			r.last[id] = last[id]
+			r.pass[id] = pass[id]
+		}
+	}
+	return res
+}
@@ -0,0 +1,257 @@
+// Package selector contains reviewer selection strategies shared by all repository backends.
+package selector
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// New returns the selector registered for the given mode.
+func New(mode entities.SelectionMode) (entities.ReviewerSelector, error) {
+	switch mode {
+	case "", entities.SelectionRandom:
+		return NewRandom(), nil
+	case entities.SelectionRoundRobin:
+		return NewRoundRobin(), nil
+	case entities.SelectionLeastLoaded:
+		return NewLeastLoaded(), nil
+	case entities.SelectionLeastOpen:
+		return NewLeastOpen(), nil
+	case entities.SelectionWorkingHours:
+		return NewWorkingHours(nil), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown selection mode %q", entities.ErrInvalidArgument, mode)
+	}
+}
+
+// Registry dispatches each request to the strategy named by its Mode,
+// falling back to the default strategy when the mode is empty or unknown.
+type Registry struct {
+	fallback   entities.ReviewerSelector
+	strategies map[entities.SelectionMode]entities.ReviewerSelector
+}
+
+// NewRegistry builds a registry of all built-in strategies with the given default mode.
+func NewRegistry(defaultMode entities.SelectionMode) (*Registry, error) {
+	strategies := map[entities.SelectionMode]entities.ReviewerSelector{
+		entities.SelectionRandom:       NewRandom(),
+		entities.SelectionRoundRobin:   NewRoundRobin(),
+		entities.SelectionLeastLoaded:  NewLeastLoaded(),
+		entities.SelectionLeastOpen:    NewLeastOpen(),
+		entities.SelectionWorkingHours: NewWorkingHours(nil),
+	}
+	if defaultMode == "" {
+		defaultMode = entities.SelectionRandom
+	}
+	fallback, ok := strategies[defaultMode]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown selection mode %q", entities.ErrInvalidArgument, defaultMode)
+	}
+	return &Registry{fallback: fallback, strategies: strategies}, nil
+}
+
+// Select routes the request to the strategy matching req.Mode.
+func (r *Registry) Select(req entities.SelectionRequest) []string {
+	if s, ok := r.strategies[req.Mode]; ok {
+		return s.Select(req)
+	}
+	return r.fallback.Select(req)
+}
+
+// withPreferred reserves the first slot for a senior candidate when req.RequireSenior is set,
+// fills up to req.Count slots from req.Preferred, then reserves one slot for the best skill match
+// of req.Labels unless a picked reviewer already matches as well, and tops up from req.Candidates,
+// delegating each step to the strategy-specific pick function.
+func withPreferred(req entities.SelectionRequest, pick func(pool []entities.Candidate, n int) []string) []string {
+	res := make([]string, 0, req.Count)
+	if req.Count <= 0 {
+		return res
+	}
+	picked := make(map[string]struct{}, req.Count)
+	if req.RequireSenior {
+		seniors := seniorCandidates(req.Preferred)
+		if len(seniors) == 0 {
+			seniors = seniorCandidates(req.Candidates)
+		}
+		for _, id := range pick(seniors, 1) {
+			res = append(res, id)
+			picked[id] = struct{}{}
+		}
+	}
+	if len(res) < req.Count && len(req.Preferred) > 0 {
+		preferred := unpicked(req.Preferred, picked)
+		for _, id := range pick(preferred, req.Count-len(res)) {
+			res = append(res, id)
+			picked[id] = struct{}{}
+		}
+	}
+	if len(res) >= req.Count {
+		return res
+	}
+
+	rest := unpicked(req.Candidates, picked)
+	if len(rest) == 0 {
+		return res
+	}
+
+	chosen := chosenCandidates(picked, req.Preferred, req.Candidates)
+	if experts := bestSkillMatches(rest, req.Labels); len(experts) > 0 && !coversAsWell(chosen, experts[0], req.Labels) {
+		expert := pick(experts, 1)
+		res = append(res, expert...)
+		if len(res) >= req.Count {
+			return res
+		}
+		rest = filterCandidates(rest, expert[0])
+		if len(rest) == 0 {
+			return res
+		}
+	}
+	return append(res, pick(rest, req.Count-len(res))...)
+}
+
+// bestSkillMatches returns candidates covering the largest non-zero number of labels.
+func bestSkillMatches(src []entities.Candidate, labels []string) []entities.Candidate {
+	if len(labels) == 0 {
+		return nil
+	}
+	best := 0
+	var res []entities.Candidate
+	for _, c := range src {
+		switch m := c.SkillMatch(labels); {
+		case m > best:
+			best = m
+			res = []entities.Candidate{c}
+		case m == best && m > 0:
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// coversAsWell reports whether an already chosen candidate matches labels at least as well as ref.
+func coversAsWell(chosen []entities.Candidate, ref entities.Candidate, labels []string) bool {
+	want := ref.SkillMatch(labels)
+	for _, c := range chosen {
+		if c.SkillMatch(labels) >= want {
+			return true
+		}
+	}
+	return false
+}
+
+func seniorCandidates(src []entities.Candidate) []entities.Candidate {
+	var res []entities.Candidate
+	for _, c := range src {
+		if c.Role.IsSenior() {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func unpicked(src []entities.Candidate, picked map[string]struct{}) []entities.Candidate {
+	res := make([]entities.Candidate, 0, len(src))
+	for _, c := range src {
+		if _, ok := picked[c.UserID]; !ok {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// chosenCandidates resolves picked ids against the given pools.
+func chosenCandidates(picked map[string]struct{}, pools ...[]entities.Candidate) []entities.Candidate {
+	res := make([]entities.Candidate, 0, len(picked))
+	seen := make(map[string]struct{}, len(picked))
+	for _, pool := range pools {
+		for _, c := range pool {
+			_, ok := picked[c.UserID]
+			if _, dup := seen[c.UserID]; ok && !dup {
+				seen[c.UserID] = struct{}{}
+				res = append(res, c)
+			}
+		}
+	}
+	return res
+}
+
+func filterCandidates(src []entities.Candidate, userID string) []entities.Candidate {
+	res := make([]entities.Candidate, 0, len(src))
+	for _, c := range src {
+		if c.UserID != userID {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func candidateIDs(src []entities.Candidate) []string {
+	ids := make([]string, 0, len(src))
+	for _, c := range src {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
+
+// pickWeighted draws up to n distinct candidates at random, each with probability proportional to its weight.
+func pickWeighted(src []entities.Candidate, n int) []string {
+	if n >= len(src) {
+		return candidateIDs(src)
+	}
+	pool := append([]entities.Candidate(nil), src...)
+	res := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		total := 0.0
+		for _, c := range pool {
+			total += c.ReviewWeight()
+		}
+		r, ok := randFloat()
+		if !ok {
+			return append(res, candidateIDs(pool[:n-i])...) // fallback deterministic slice
+		}
+		r *= total
+		idx := len(pool) - 1
+		for j, c := range pool {
+			if r < c.ReviewWeight() {
+				idx = j
+				break
+			}
+			r -= c.ReviewWeight()
+		}
+		res = append(res, pool[idx].UserID)
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+	return res
+}
+
+func shuffle(src []entities.Candidate) []entities.Candidate {
+	res := append([]entities.Candidate(nil), src...)
+	for i := len(res) - 1; i > 0; i-- {
+		j, ok := randIndex(i + 1)
+		if !ok {
+			return res
+		}
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+// randFloat returns a uniformly distributed number in [0, 1).
+func randFloat() (float64, bool) {
+	v, err := rand.Int(rand.Reader, big.NewInt(1<<53))
+	if err != nil {
+		return 0, false
+	}
+	return float64(v.Int64()) / (1 << 53), true
+}
+
+func randIndex(n int) (int, bool) {
+	idxBig, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, false
+	}
+	return int(idxBig.Int64()), true
+}
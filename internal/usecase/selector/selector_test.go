@@ -0,0 +1,275 @@
+package selector
+
+import (
+	"testing"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func candidates(ids ...string) []entities.Candidate {
+	res := make([]entities.Candidate, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, entities.Candidate{UserID: id})
+	}
+	return res
+}
+
+func TestNewUnknownMode(t *testing.T) {
+	_, err := New("unknown")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	sel, err := New("")
+	require.NoError(t, err)
+	require.IsType(t, &Random{}, sel)
+}
+
+func TestRandomSelect(t *testing.T) {
+	sel := NewRandom()
+
+	picked := sel.Select(entities.SelectionRequest{Candidates: candidates("u1", "u2", "u3"), Count: 2})
+	require.Len(t, picked, 2)
+	require.NotEqual(t, picked[0], picked[1])
+	require.Subset(t, []string{"u1", "u2", "u3"}, picked)
+
+	require.ElementsMatch(t, []string{"u1"}, sel.Select(entities.SelectionRequest{Candidates: candidates("u1"), Count: 2}))
+	require.Empty(t, sel.Select(entities.SelectionRequest{Count: 2}))
+}
+
+func TestRoundRobinRotates(t *testing.T) {
+	sel := NewRoundRobin()
+	pool := candidates("u1", "u2", "u3")
+
+	require.Equal(t, []string{"u1", "u2"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2}))
+	require.Equal(t, []string{"u3", "u1"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2}))
+	require.Equal(t, []string{"u2"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+}
+
+func TestRoundRobinDryRunKeepsRotation(t *testing.T) {
+	sel := NewRoundRobin()
+	pool := candidates("u1", "u2", "u3")
+
+	require.Equal(t, []string{"u1"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1, DryRun: true}))
+	require.Equal(t, []string{"u1"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+	require.Equal(t, []string{"u2"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+}
+
+func TestLeastLoadedPrefersFewestAssignments(t *testing.T) {
+	sel := NewLeastLoaded()
+	pool := []entities.Candidate{
+		{UserID: "u1", AssignCnt: 5},
+		{UserID: "u2", AssignCnt: 0},
+		{UserID: "u3", AssignCnt: 2},
+	}
+
+	require.Equal(t, []string{"u2", "u3"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2}))
+}
+
+func TestLeastOpenBreaksTiesAmongLeastBusy(t *testing.T) {
+	sel := NewLeastOpen()
+	pool := []entities.Candidate{
+		{UserID: "u1", OpenReviews: 3, AssignCnt: 0},
+		{UserID: "u2", OpenReviews: 0, AssignCnt: 9},
+		{UserID: "u3", OpenReviews: 0, AssignCnt: 9},
+		{UserID: "u4", OpenReviews: 1},
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		picked := sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1})
+		require.Len(t, picked, 1)
+		require.Contains(t, []string{"u2", "u3"}, picked[0])
+		seen[picked[0]] = true
+	}
+	require.Len(t, seen, 2)
+
+	require.ElementsMatch(t, []string{"u2", "u3", "u4"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 3}))
+}
+
+func TestLeastOpenScalesLoadByWeight(t *testing.T) {
+	sel := NewLeastOpen()
+	pool := []entities.Candidate{
+		{UserID: "half", OpenReviews: 2, Weight: 0.5},
+		{UserID: "full", OpenReviews: 3, Weight: 1},
+	}
+
+	require.Equal(t, []string{"full"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+}
+
+func TestRandomFollowsWeights(t *testing.T) {
+	sel := NewRandom()
+	pool := []entities.Candidate{
+		{UserID: "heavy", Weight: 99},
+		{UserID: "light", Weight: 1},
+	}
+
+	heavy := 0
+	for i := 0; i < 500; i++ {
+		if sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1})[0] == "heavy" {
+			heavy++
+		}
+	}
+	require.Greater(t, heavy, 400)
+	require.ElementsMatch(t, []string{"heavy", "light"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2}))
+}
+
+func TestRoundRobinFollowsWeights(t *testing.T) {
+	sel := NewRoundRobin()
+	pool := []entities.Candidate{
+		{UserID: "full", Weight: 1},
+		{UserID: "half", Weight: 0.5},
+	}
+
+	picks := map[string]int{}
+	for i := 0; i < 30; i++ {
+		picks[sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1})[0]]++
+	}
+	require.Equal(t, 20, picks["full"])
+	require.Equal(t, 10, picks["half"])
+}
+
+func TestRoundRobinReturningCandidateDoesNotCatchUp(t *testing.T) {
+	sel := NewRoundRobin()
+	all := candidates("u1", "u2", "u3")
+	for i := 0; i < 9; i++ {
+		sel.Select(entities.SelectionRequest{Candidates: all[:2], Count: 1})
+	}
+
+	require.Equal(t, []string{"u3"}, sel.Select(entities.SelectionRequest{Candidates: all, Count: 1}))
+	picks := map[string]int{}
+	for i := 0; i < 6; i++ {
+		picks[sel.Select(entities.SelectionRequest{Candidates: all, Count: 1})[0]]++
+	}
+	require.Equal(t, map[string]int{"u1": 2, "u2": 2, "u3": 2}, picks)
+}
+
+func TestRegistryRoutesByMode(t *testing.T) {
+	_, err := NewRegistry("unknown")
+	require.ErrorIs(t, err, entities.ErrInvalidArgument)
+
+	reg, err := NewRegistry(entities.SelectionLeastLoaded)
+	require.NoError(t, err)
+
+	pool := []entities.Candidate{
+		{UserID: "u1", AssignCnt: 0, OpenReviews: 4},
+		{UserID: "u2", AssignCnt: 7, OpenReviews: 0},
+	}
+	require.Equal(t, []string{"u1"}, reg.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+	require.Equal(t, []string{"u2"}, reg.Select(entities.SelectionRequest{Mode: entities.SelectionLeastOpen, Candidates: pool, Count: 1}))
+}
+
+func TestPreferredCandidatesFillFirst(t *testing.T) {
+	sels := map[string]entities.ReviewerSelector{
+		"random":       NewRandom(),
+		"round_robin":  NewRoundRobin(),
+		"least_loaded": NewLeastLoaded(),
+		"least_open":   NewLeastOpen(),
+	}
+	for name, sel := range sels {
+		t.Run(name, func(t *testing.T) {
+			picked := sel.Select(entities.SelectionRequest{
+				Preferred:  candidates("owner"),
+				Candidates: candidates("owner", "u1"),
+				Count:      2,
+			})
+			require.Equal(t, []string{"owner", "u1"}, picked)
+
+			picked = sel.Select(entities.SelectionRequest{
+				Preferred:  candidates("o1", "o2", "o3"),
+				Candidates: candidates("u1", "u2"),
+				Count:      2,
+			})
+			require.Len(t, picked, 2)
+			require.Subset(t, []string{"o1", "o2", "o3"}, picked)
+		})
+	}
+}
+
+func TestSkillMatchReservesSlot(t *testing.T) {
+	sels := map[string]entities.ReviewerSelector{
+		"random":       NewRandom(),
+		"round_robin":  NewRoundRobin(),
+		"least_loaded": NewLeastLoaded(),
+		"least_open":   NewLeastOpen(),
+	}
+	pool := []entities.Candidate{
+		{UserID: "u1", OpenReviews: 0},
+		{UserID: "u2", OpenReviews: 0},
+		{UserID: "partial", OpenReviews: 5, AssignCnt: 5, Skills: []string{"go"}},
+		{UserID: "expert", OpenReviews: 9, AssignCnt: 9, Skills: []string{"go", "sql"}},
+	}
+	for name, sel := range sels {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				picked := sel.Select(entities.SelectionRequest{Candidates: pool, Labels: []string{"go", "sql"}, Count: 2})
+				require.Len(t, picked, 2)
+				require.Equal(t, "expert", picked[0])
+			}
+
+			picked := sel.Select(entities.SelectionRequest{
+				Preferred:  []entities.Candidate{{UserID: "owner", Skills: []string{"go", "sql"}}},
+				Candidates: pool,
+				Labels:     []string{"go", "sql"},
+				Count:      1,
+			})
+			require.Equal(t, []string{"owner"}, picked)
+		})
+	}
+}
+
+func TestRequireSeniorReservesSlot(t *testing.T) {
+	sels := map[string]entities.ReviewerSelector{
+		"random":       NewRandom(),
+		"round_robin":  NewRoundRobin(),
+		"least_loaded": NewLeastLoaded(),
+		"least_open":   NewLeastOpen(),
+	}
+	pool := []entities.Candidate{
+		{UserID: "u1"},
+		{UserID: "u2"},
+		{UserID: "u3"},
+		{UserID: "lead", OpenReviews: 9, AssignCnt: 9, Role: entities.RoleLead},
+	}
+	for name, sel := range sels {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				picked := sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2, RequireSenior: true})
+				require.Len(t, picked, 2)
+				require.Equal(t, "lead", picked[0])
+			}
+
+			picked := sel.Select(entities.SelectionRequest{
+				Preferred:     []entities.Candidate{{UserID: "owner"}, {UserID: "senior-owner", Role: entities.RoleSenior}},
+				Candidates:    pool,
+				Count:         1,
+				RequireSenior: true,
+			})
+			require.Equal(t, []string{"senior-owner"}, picked)
+
+			picked = sel.Select(entities.SelectionRequest{Candidates: candidates("u1", "u2"), Count: 1, RequireSenior: true})
+			require.Len(t, picked, 1)
+		})
+	}
+}
+
+func TestWorkingHoursPrefersCandidatesAtWork(t *testing.T) {
+	// Monday 10:00 UTC: 13:00 in Moscow, 02:00 in Los Angeles.
+	now := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	sel := NewWorkingHours(func() time.Time { return now })
+
+	schedule := func(tz string) entities.WorkSchedule {
+		s := entities.DefaultWorkSchedule()
+		s.TimeZone = tz
+		return s
+	}
+	pool := []entities.Candidate{
+		{UserID: "la", Schedule: schedule("America/Los_Angeles")},
+		{UserID: "msk", Schedule: schedule("Europe/Moscow")},
+	}
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, []string{"msk"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 1}))
+	}
+	require.Equal(t, []string{"msk", "la"}, sel.Select(entities.SelectionRequest{Candidates: pool, Count: 2}))
+}
@@ -0,0 +1,42 @@
+package selector
+
+import (
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+)
+
+// WorkingHours prefers candidates currently inside their working hours and falls back to the rest.
+// Candidates within each group are picked randomly in proportion to their review weight.
+type WorkingHours struct {
+	now func() time.Time
+}
+
+// NewWorkingHours constructs a working-hours aware selector; a nil clock means time.Now.
+func NewWorkingHours(now func() time.Time) *WorkingHours {
+	if now == nil {
+		now = time.Now
+	}
+	return &WorkingHours{now: now}
+}
+
+// Select returns up to req.Count candidates, those at work right now first.
+func (w *WorkingHours) Select(req entities.SelectionRequest) []string {
+	now := w.now()
+	return withPreferred(req, func(pool []entities.Candidate, n int) []string {
+		working := make([]entities.Candidate, 0, len(pool))
+		away := make([]entities.Candidate, 0, len(pool))
+		for _, c := range pool {
+			if c.Schedule.IsWorking(now) {
+				working = append(working, c)
+			} else {
+				away = append(away, c)
+			}
+		}
+		res := pickWeighted(working, n)
+		if len(res) < n {
+			res = append(res, pickWeighted(away, n-len(res))...)
+		}
+		return res
+	})
+}
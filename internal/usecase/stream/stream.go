@@ -0,0 +1,263 @@
+// Package stream fans the persisted domain event log out to live subscribers.
+package stream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+
+	"go.uber.org/zap"
+)
+
+const (
+	pageSize   = 500
+	bufferSize = 256
+)
+
+var (
+	// ErrLagged ends a subscription that fell too far behind; it may resume from its last event.
+	ErrLagged = errors.New("event subscriber lagged behind")
+	// ErrClosed ends subscriptions when the broker stops.
+	ErrClosed = errors.New("event stream closed")
+)
+
+// Store is the event log the broker reads.
+type Store interface {
+	ListEvents(ctx context.Context, afterID int64, filter entities.EventFilter, limit int) ([]entities.Event, error)
+	LastEventID(ctx context.Context) (int64, error)
+}
+
+// Broker polls the event log and hands new events to subscribers in ID order.
+type Broker struct {
+	log   *zap.SugaredLogger
+	store Store
+	ready chan struct{}
+
+	mu       sync.Mutex
+	cursor   int64
+	subs     map[*Subscription]struct{}
+	closed   bool
+	disabled bool
+
+	// gapSince is when the poller first saw the ID after cursor missing.
+	gapSince time.Time
+}
+
+// NewBroker constructs a Broker; it serves subscribers once Run has started.
+func NewBroker(log *zap.SugaredLogger, store Store) *Broker {
+	return &Broker{
+		log:   log,
+		store: store,
+		ready: make(chan struct{}),
+		subs:  make(map[*Subscription]struct{}),
+	}
+}
+
+// Run polls for new events every interval until ctx is done, then ends all subscriptions.
+// IDs are taken in insert order but become visible in commit order, so a missing ID is
+// waited for up to gapTimeout before it is taken for a rolled back transaction and skipped.
+func (b *Broker) Run(ctx context.Context, interval, gapTimeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer b.closeAll()
+
+	started := false
+	for {
+		if !started {
+			last, err := b.store.LastEventID(ctx)
+			if err == nil {
+				b.cursor = last
+				started = true
+				close(b.ready)
+			} else if ctx.Err() == nil {
+				b.log.Errorw("event stream failed to start", "error", err)
+			}
+		}
+		if started {
+			if err := b.poll(ctx, gapTimeout); err != nil && ctx.Err() == nil {
+				b.log.Errorw("event stream poll failed", "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Disable turns the broker off when Run is never going to start, so subscribers fail
+// with entities.ErrStreamDisabled instead of waiting for it.
+func (b *Broker) Disable() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.disabled {
+		return
+	}
+	b.disabled = true
+	close(b.ready)
+}
+
+// Subscribe starts a subscription to events matching filter. With a positive lastEventID it
+// first replays the logged events after it, otherwise it only receives new events.
+func (b *Broker) Subscribe(ctx context.Context, filter entities.EventFilter, lastEventID int64) (*Subscription, error) {
+	select {
+	case <-b.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.disabled {
+		return nil, entities.ErrStreamDisabled
+	}
+	if b.closed {
+		return nil, ErrClosed
+	}
+	// Events up to the cursor are replayed from the log, later ones arrive live.
+	start := max(lastEventID, b.cursor)
+	sub := &Subscription{
+		broker:    b,
+		filter:    filter,
+		liveAfter: start,
+		after:     start,
+		replayTo:  start,
+		live:      make(chan entities.Event, bufferSize),
+	}
+	if lastEventID > 0 && lastEventID < b.cursor {
+		sub.after = lastEventID
+	}
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+func (b *Broker) poll(ctx context.Context, gapTimeout time.Duration) error {
+	for {
+		events, err := b.store.ListEvents(ctx, b.cursor, entities.EventFilter{}, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if e.ID > b.cursor+1 {
+				if b.gapSince.IsZero() {
+					b.gapSince = time.Now()
+				}
+				if time.Since(b.gapSince) < gapTimeout {
+					return nil
+				}
+				b.log.Warnw("skipping missing event ids", "from", b.cursor+1, "to", e.ID-1)
+			}
+			b.gapSince = time.Time{}
+			b.publish(e)
+		}
+		if len(events) < pageSize {
+			return nil
+		}
+	}
+}
+
+func (b *Broker) publish(e entities.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cursor = e.ID
+	for sub := range b.subs {
+		if e.ID <= sub.liveAfter || !sub.filter.Matches(e) {
+			continue
+		}
+		select {
+		case sub.live <- e:
+		default:
+			b.log.Warnw("dropping lagging event subscriber", "event_id", e.ID)
+			b.drop(sub, ErrLagged)
+		}
+	}
+}
+
+func (b *Broker) closeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		b.drop(sub, ErrClosed)
+	}
+}
+
+// drop removes sub with the given reason; b.mu must be held.
+func (b *Broker) drop(sub *Subscription, reason error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	sub.err = reason
+	close(sub.live)
+}
+
+// Subscription delivers the replayed backlog and then live events of one subscriber.
+type Subscription struct {
+	broker    *Broker
+	filter    entities.EventFilter
+	liveAfter int64
+	// after is the last replayed event ID; the backlog ends at replayTo.
+	after    int64
+	replayTo int64
+	backlog  []entities.Event
+	live     chan entities.Event
+	// err is set under broker.mu before live is closed.
+	err error
+}
+
+// Next returns the next event, blocking until one arrives, ctx is done or the subscription ends.
+func (s *Subscription) Next(ctx context.Context) (entities.Event, error) {
+	for len(s.backlog) == 0 && s.after < s.replayTo {
+		if err := s.loadBacklog(ctx); err != nil {
+			return entities.Event{}, err
+		}
+	}
+	if len(s.backlog) > 0 {
+		e := s.backlog[0]
+		s.backlog = s.backlog[1:]
+		return e, nil
+	}
+
+	select {
+	case e, ok := <-s.live:
+		if !ok {
+			return entities.Event{}, s.err
+		}
+		return e, nil
+	case <-ctx.Done():
+		return entities.Event{}, ctx.Err()
+	}
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.drop(s, ErrClosed)
+}
+
+func (s *Subscription) loadBacklog(ctx context.Context) error {
+	events, err := s.broker.store.ListEvents(ctx, s.after, s.filter, pageSize)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		if e.ID > s.replayTo {
+			break
+		}
+		s.backlog = append(s.backlog, e)
+		s.after = e.ID
+	}
+	if len(events) < pageSize || s.after < events[len(events)-1].ID {
+		s.after = s.replayTo
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeLog struct {
+	mu     sync.Mutex
+	events []entities.Event
+}
+
+func (l *fakeLog) add(id int64, users ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, entities.Event{ID: id, Type: entities.EventReviewerAssigned, UserIDs: users})
+}
+
+func (l *fakeLog) ListEvents(_ context.Context, afterID int64, filter entities.EventFilter, limit int) ([]entities.Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	res := make([]entities.Event, 0)
+	for _, e := range l.events {
+		if e.ID > afterID && filter.Matches(e) && len(res) < limit {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+func (l *fakeLog) LastEventID(context.Context) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.events) == 0 {
+		return 0, nil
+	}
+	return l.events[len(l.events)-1].ID, nil
+}
+
+func startBroker(t *testing.T, log *fakeLog, gapTimeout time.Duration) *Broker {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	b := NewBroker(zap.NewNop().Sugar(), log)
+	go b.Run(ctx, 5*time.Millisecond, gapTimeout)
+	return b
+}
+
+func nextIDs(t *testing.T, sub *Subscription, n int) []int64 {
+	t.Helper()
+	ids := make([]int64, 0, n)
+	for len(ids) < n {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		e, err := sub.Next(ctx)
+		cancel()
+		require.NoError(t, err)
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func TestSubscribeReplaysThenStreamsLive(t *testing.T) {
+	log := &fakeLog{}
+	log.add(1, "u1")
+	log.add(2, "u2")
+	log.add(3, "u1")
+	b := startBroker(t, log, time.Second)
+
+	sub, err := b.Subscribe(context.Background(), entities.EventFilter{UserID: "u1"}, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+	fresh, err := b.Subscribe(context.Background(), entities.EventFilter{}, 0)
+	require.NoError(t, err)
+	defer fresh.Close()
+
+	log.add(4, "u2")
+	log.add(5, "u1", "u2")
+	require.Equal(t, []int64{3, 5}, nextIDs(t, sub, 2))
+	require.Equal(t, []int64{4, 5}, nextIDs(t, fresh, 2))
+}
+
+func TestDisabledBrokerRejectsSubscribers(t *testing.T) {
+	b := NewBroker(zap.NewNop().Sugar(), &fakeLog{})
+	b.Disable()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := b.Subscribe(ctx, entities.EventFilter{}, 0)
+	require.ErrorIs(t, err, entities.ErrStreamDisabled)
+}
+
+func TestBrokerWaitsForGapsInOrder(t *testing.T) {
+	log := &fakeLog{}
+	b := startBroker(t, log, time.Hour)
+	sub, err := b.Subscribe(context.Background(), entities.EventFilter{}, 0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	// Event 2 is committed before event 1: neither may overtake the other.
+	log.add(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	_, err = sub.Next(ctx)
+	cancel()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	log.mu.Lock()
+	log.events = append([]entities.Event{{ID: 1}}, log.events...)
+	log.mu.Unlock()
+	require.Equal(t, []int64{1, 2}, nextIDs(t, sub, 2))
+}
+
+func TestBrokerSkipsExpiredGaps(t *testing.T) {
+	log := &fakeLog{}
+	b := startBroker(t, log, 20*time.Millisecond)
+	sub, err := b.Subscribe(context.Background(), entities.EventFilter{}, 0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	log.add(2)
+	require.Equal(t, []int64{2}, nextIDs(t, sub, 1))
+}
+
+func TestLaggingSubscriberIsDropped(t *testing.T) {
+	log := &fakeLog{}
+	b := startBroker(t, log, time.Second)
+	sub, err := b.Subscribe(context.Background(), entities.EventFilter{}, 0)
+	require.NoError(t, err)
+
+	for id := int64(1); id <= bufferSize+1; id++ {
+		log.add(id)
+	}
+	require.Eventually(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return b.cursor == bufferSize+1
+	}, time.Second, time.Millisecond)
+	var lastErr error
+	for lastErr == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, lastErr = sub.Next(ctx)
+		cancel()
+	}
+	require.ErrorIs(t, lastErr, ErrLagged)
+}
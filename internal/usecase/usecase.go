@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"assigning-reviewers-for-pr/internal/entities"
 	"assigning-reviewers-for-pr/internal/repository"
 	"assigning-reviewers-for-pr/internal/usecase/domain"
 
@@ -13,12 +14,24 @@ import (
 // InterfaceUsecase aggregates all usecase interfaces.
 type InterfaceUsecase interface {
 	UserUsecaseInterface
+	AvailabilityUsecaseInterface
 	TeamUsecaseInterface
 	PullRequestUsecaseInterface
+	CodeHostUsecaseInterface
+	OwnershipUsecaseInterface
+	PairingUsecaseInterface
+	WebhookUsecaseInterface
+	EventUsecaseInterface
 	StatsUsecaseInterface
 }
 
 // New constructs a new usecase layer with its dependencies.
-func New(log *zap.SugaredLogger, ctx context.Context, repo repository.Repository, timeout time.Duration) InterfaceUsecase {
-	return domain.New(log, ctx, repo, timeout)
+func New(
+	log *zap.SugaredLogger,
+	ctx context.Context,
+	repo repository.Repository,
+	selector entities.ReviewerSelector,
+	timeout time.Duration,
+) InterfaceUsecase {
+	return domain.New(log, ctx, repo, selector, timeout)
 }
@@ -0,0 +1,163 @@
+// Package webhook delivers queued domain events to webhook subscribers.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"assigning-reviewers-for-pr/internal/usecase/outbox"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the request body.
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader carries the event type.
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader carries the delivery id, stable across retries.
+	DeliveryHeader = "X-Webhook-Delivery"
+)
+
+// Store is the outbox the dispatcher reads deliveries from.
+type Store interface {
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, attempt entities.WebhookAttempt) error
+}
+
+// Config tunes delivery batching and retries.
+type Config struct {
+	BatchSize   int
+	MaxAttempts int
+	// BackoffBase is the delay after the first failed attempt; it doubles with every further one up to BackoffMax.
+	BackoffBase    time.Duration
+	BackoffMax     time.Duration
+	RequestTimeout time.Duration
+}
+
+// Dispatcher sends due deliveries and records their outcome.
+type Dispatcher struct {
+	log    *zap.SugaredLogger
+	store  Store
+	client *http.Client
+	cfg    Config
+}
+
+// NewDispatcher constructs a Dispatcher, filling unset config values with defaults.
+func NewDispatcher(log *zap.SugaredLogger, store Store, client *http.Client, cfg Config) *Dispatcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = 10 * time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = time.Hour
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = 5 * time.Second
+	}
+	return &Dispatcher{log: log, store: store, client: client, cfg: cfg}
+}
+
+// Sign returns the signature header value of body for secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// DispatchDue sends one batch of due deliveries and returns how many were delivered.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	lease := outbox.Lease(d.cfg.BatchSize, 1, d.cfg.RequestTimeout)
+	deliveries, err := d.store.ClaimWebhookDeliveries(ctx, d.cfg.BatchSize, lease)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		attempt := d.send(ctx, delivery)
+		if ctx.Err() != nil {
+			// Shutting down: the lease expires and the delivery is retried without counting this attempt.
+			return delivered, ctx.Err()
+		}
+		if err := d.store.RecordWebhookAttempt(ctx, attempt); err != nil {
+			return delivered, err
+		}
+		switch attempt.Status {
+		case entities.DeliveryDelivered:
+			delivered++
+		case entities.DeliveryFailed:
+			d.log.Warnw("webhook delivery gave up", "delivery_id", delivery.ID, "url", delivery.URL, "attempts", delivery.Attempts+1, "error", attempt.Error)
+		default:
+			d.log.Infow("webhook delivery will be retried", "delivery_id", delivery.ID, "retry_in", attempt.RetryIn, "error", attempt.Error)
+		}
+	}
+	return delivered, nil
+}
+
+// Run dispatches due deliveries every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	outbox.Run(ctx, d.log, "webhook deliveries", interval, d.DispatchDue)
+}
+
+// send posts delivery once and turns the response into an attempt outcome.
+func (d *Dispatcher) send(ctx context.Context, delivery entities.WebhookDelivery) entities.WebhookAttempt {
+	attempt := entities.WebhookAttempt{DeliveryID: delivery.ID, Status: entities.DeliveryDelivered}
+
+	code, err := d.post(ctx, delivery)
+	if code != 0 {
+		attempt.ResponseCode = &code
+	}
+	if err == nil && (code < 200 || code > 299) {
+		err = fmt.Errorf("unexpected status %d", code)
+	}
+	if err == nil {
+		return attempt
+	}
+
+	attempt.Error = err.Error()
+	if delivery.Attempts+1 >= d.cfg.MaxAttempts {
+		attempt.Status = entities.DeliveryFailed
+		return attempt
+	}
+	attempt.Status = entities.DeliveryPending
+	attempt.RetryIn = outbox.Backoff(delivery.Attempts+1, d.cfg.BackoffBase, d.cfg.BackoffMax)
+	return attempt
+}
+
+func (d *Dispatcher) post(ctx context.Context, delivery entities.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, delivery.Payload))
+	req.Header.Set(EventHeader, string(delivery.Event))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"assigning-reviewers-for-pr/internal/entities"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeStore struct {
+	due      []entities.WebhookDelivery
+	lease    time.Duration
+	attempts []entities.WebhookAttempt
+}
+
+func (s *fakeStore) ClaimWebhookDeliveries(_ context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	s.lease = lease
+	n := min(limit, len(s.due))
+	res := s.due[:n]
+	s.due = s.due[n:]
+	return res, nil
+}
+
+func (s *fakeStore) RecordWebhookAttempt(_ context.Context, attempt entities.WebhookAttempt) error {
+	s.attempts = append(s.attempts, attempt)
+	return nil
+}
+
+func newTestDispatcher(store Store) *Dispatcher {
+	return NewDispatcher(zap.NewNop().Sugar(), store, nil, Config{
+		BatchSize:      10,
+		MaxAttempts:    3,
+		BackoffBase:    time.Second,
+		BackoffMax:     3 * time.Second,
+		RequestTimeout: time.Second,
+	})
+}
+
+func TestDispatchDueSignsAndDelivers(t *testing.T) {
+	payload := []byte(`{"event":"pr.created","data":{}}`)
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	store := &fakeStore{due: []entities.WebhookDelivery{
+		{ID: 7, Event: entities.EventPRCreated, URL: srv.URL, Secret: "s3cret", Payload: payload},
+	}}
+	n, err := newTestDispatcher(store).DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	require.Equal(t, payload, body)
+	require.Equal(t, Sign("s3cret", payload), got.Header.Get(SignatureHeader))
+	require.Equal(t, "pr.created", got.Header.Get(EventHeader))
+	require.Equal(t, "7", got.Header.Get(DeliveryHeader))
+	require.Equal(t, "application/json", got.Header.Get("Content-Type"))
+
+	require.Len(t, store.attempts, 1)
+	require.Equal(t, entities.DeliveryDelivered, store.attempts[0].Status)
+	require.Equal(t, http.StatusNoContent, *store.attempts[0].ResponseCode)
+	require.Empty(t, store.attempts[0].Error)
+	require.Greater(t, store.lease, 10*time.Second)
+}
+
+func TestDispatchDueRetriesWithBackoff(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	due := make([]entities.WebhookDelivery, 0, 4)
+	for attempts := 0; attempts < 3; attempts++ {
+		due = append(due, entities.WebhookDelivery{ID: int64(attempts + 1), Attempts: attempts, URL: srv.URL, Secret: "s"})
+	}
+	// Unreachable endpoints fail without a response code.
+	due = append(due, entities.WebhookDelivery{ID: 4, URL: "http://127.0.0.1:1", Secret: "s"})
+	store := &fakeStore{due: due}
+
+	n, err := newTestDispatcher(store).DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Len(t, store.attempts, 4)
+
+	for i, want := range []struct {
+		status  entities.DeliveryStatus
+		retryIn time.Duration
+	}{
+		{entities.DeliveryPending, time.Second},
+		{entities.DeliveryPending, 2 * time.Second},
+		{entities.DeliveryFailed, 0},
+	} {
+		a := store.attempts[i]
+		require.Equal(t, want.status, a.Status, "attempt %d", i)
+		require.Equal(t, want.retryIn, a.RetryIn, "attempt %d", i)
+		require.Equal(t, http.StatusInternalServerError, *a.ResponseCode)
+		require.Contains(t, a.Error, strconv.Itoa(http.StatusInternalServerError))
+	}
+	require.Equal(t, entities.DeliveryPending, store.attempts[3].Status)
+	require.Nil(t, store.attempts[3].ResponseCode)
+	require.NotEmpty(t, store.attempts[3].Error)
+}